@@ -0,0 +1,200 @@
+// Command migrate manages the schema of the service-order database with the
+// migrations embedded from db/migrations.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pressly/goose/v3"
+
+	"github.com/Tortik3000/service-order/db"
+	"github.com/Tortik3000/service-order/internal/config"
+	"github.com/Tortik3000/service-order/pkg/phone"
+)
+
+const usage = `Usage: migrate [flags] <command> [args]
+
+Commands:
+  up [version]     apply all pending migrations, or those up to version
+  down [version]   roll back the last migration, or all those after version
+  redo             roll back the last migration and apply it again
+  status           list the migrations and when they were applied
+  version          print the version of the database
+  create <name>    add an empty SQL migration to -dir
+
+Flags:
+`
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	configPath := flag.String("config", os.Getenv("CONFIG_FILE"), "path to the YAML config file")
+	dir := flag.String("dir", "db/migrations", "directory create adds migrations to")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	command, args := flag.Arg(0), flag.Args()[1:]
+
+	if err := run(command, args, *configPath, *dir); err != nil {
+		fmt.Fprintf(os.Stderr, "migrate %s: %v\n", command, err)
+		os.Exit(1)
+	}
+}
+
+func run(command string, args []string, configPath, dir string) error {
+	if command == "create" {
+		if len(args) != 1 {
+			return errors.New("expected the name of the migration")
+		}
+		path, err := db.Create(dir, args[0])
+		if err != nil {
+			return err
+		}
+		fmt.Println("created", path)
+		return nil
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	cfg, err := config.LoadMigrations(configPath)
+	if err != nil {
+		return fmt.Errorf("load config: %w", err)
+	}
+	// The pool is not tuned like the service's one: migrations may take
+	// longer than its statement timeout.
+	pool, err := pgxpool.New(ctx, cfg.Database.DSN)
+	if err != nil {
+		return fmt.Errorf("create pool: %w", err)
+	}
+	defer pool.Close()
+
+	phoneParser, err := phone.NewParser(cfg.Phone.DefaultRegion)
+	if err != nil {
+		return fmt.Errorf("create phone parser: %w", err)
+	}
+
+	provider, err := db.NewProvider(pool, phoneParser)
+	if err != nil {
+		return fmt.Errorf("create migration provider: %w", err)
+	}
+
+	switch command {
+	case "up":
+		version, ok, err := optionalVersion(args)
+		if err != nil {
+			return err
+		}
+		var results []*goose.MigrationResult
+		if !ok {
+			results, err = provider.Up(ctx)
+		} else {
+			results, err = provider.UpTo(ctx, version)
+		}
+		printResults(results)
+		if err != nil {
+			return err
+		}
+		if len(results) == 0 {
+			fmt.Println("no pending migrations")
+		}
+		return nil
+
+	case "down":
+		version, ok, err := optionalVersion(args)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			result, err := provider.Down(ctx)
+			printResults([]*goose.MigrationResult{result})
+			return err
+		}
+		results, err := provider.DownTo(ctx, version)
+		printResults(results)
+		return err
+
+	case "redo":
+		if len(args) != 0 {
+			return errors.New("expected no arguments")
+		}
+		down, err := provider.Down(ctx)
+		printResults([]*goose.MigrationResult{down})
+		if err != nil {
+			return err
+		}
+		up, err := provider.ApplyVersion(ctx, down.Source.Version, true)
+		printResults([]*goose.MigrationResult{up})
+		return err
+
+	case "status":
+		statuses, err := provider.Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tSTATE\tAPPLIED AT\tFILE")
+		for _, s := range statuses {
+			appliedAt := "-"
+			if !s.AppliedAt.IsZero() {
+				appliedAt = s.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", s.Source.Version, s.State, appliedAt, s.Source.Path)
+		}
+		return w.Flush()
+
+	case "version":
+		current, target, err := provider.GetVersions(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("version %d, latest %d\n", current, target)
+		return nil
+	}
+
+	return fmt.Errorf("unknown command, see migrate -h")
+}
+
+// optionalVersion parses the version argument of up and down and reports
+// whether there is one. down 0 rolls back every migration.
+func optionalVersion(args []string) (int64, bool, error) {
+	switch len(args) {
+	case 0:
+		return 0, false, nil
+	case 1:
+		version, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil || version < 0 {
+			return 0, false, fmt.Errorf("invalid version %q", args[0])
+		}
+		return version, true, nil
+	}
+	return 0, false, errors.New("expected at most one version")
+}
+
+func printResults(results []*goose.MigrationResult) {
+	for _, r := range results {
+		if r == nil || r.Source == nil {
+			continue
+		}
+		state := "OK"
+		if r.Error != nil {
+			state = "FAILED"
+		}
+		fmt.Printf("%-4s %s %d %s (%s)\n", r.Direction, state, r.Source.Version, r.Source.Path, r.Duration.Round(time.Millisecond))
+	}
+}
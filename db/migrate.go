@@ -2,27 +2,87 @@ package db
 
 import (
 	"embed"
+	"fmt"
+	"io/fs"
 	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/pressly/goose/v3"
-	"go.uber.org/zap"
+	"github.com/pressly/goose/v3/lock"
 )
 
 //go:embed migrations/*.sql
 var embedMigrations embed.FS
 
-func SetupPostgres(pool *pgxpool.Pool, logger *zap.Logger) {
-	goose.SetBaseFS(embedMigrations)
-	if err := goose.SetDialect("postgres"); err != nil {
-		logger.Error("can not set dialect in goose", zap.Error(err))
-		os.Exit(1)
+// NewProvider returns a goose provider of the embedded migrations over pool.
+// Migrations run under a Postgres advisory lock, so processes migrating the
+// same database at once take turns instead of racing. phones normalizes the
+// stored phone numbers in the Go migrations.
+func NewProvider(pool *pgxpool.Pool, phones PhoneNormalizer) (*goose.Provider, error) {
+	migrations, err := fs.Sub(embedMigrations, "migrations")
+	if err != nil {
+		return nil, err
 	}
+	locker, err := lock.NewPostgresSessionLocker()
+	if err != nil {
+		return nil, fmt.Errorf("create migration lock: %w", err)
+	}
+	return goose.NewProvider(goose.DialectPostgres, stdlib.OpenDBFromPool(pool), migrations,
+		goose.WithSessionLocker(locker),
+		goose.WithGoMigrations(normalizePhonesMigration(phones)),
+	)
+}
+
+var (
+	migrationFileRe = regexp.MustCompile(`^(\d+)_.*\.sql$`)
+	migrationNameRe = regexp.MustCompile(`^[a-z0-9_]+$`)
+)
+
+const migrationTemplate = `-- +goose Up
+
+-- +goose Down
+`
+
+// Create adds an empty SQL migration called name to dir, numbered after the
+// last migration there, and returns its path. The migration is embedded
+// into the binaries once they are rebuilt.
+func Create(dir, name string) (string, error) {
+	name = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(name), " ", "_"))
+	if !migrationNameRe.MatchString(name) {
+		return "", fmt.Errorf("migration name %q must consist of letters, digits and underscores", name)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", fmt.Errorf("read migrations: %w", err)
+	}
+	var last int64
+	for _, entry := range entries {
+		m := migrationFileRe.FindStringSubmatch(entry.Name())
+		if m == nil {
+			continue
+		}
+		version, err := strconv.ParseInt(m[1], 10, 64)
+		if err != nil {
+			return "", fmt.Errorf("parse version of %s: %w", entry.Name(), err)
+		}
+		last = max(last, version)
+	}
+
+	path := filepath.Join(dir, fmt.Sprintf("%03d_%s.sql", last+1, name))
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return "", fmt.Errorf("create migration: %w", err)
+	}
+	defer file.Close()
 
-	db := stdlib.OpenDBFromPool(pool)
-	if err := goose.Up(db, "migrations"); err != nil {
-		logger.Error("can not setup migrations", zap.Error(err))
-		os.Exit(1)
+	if _, err := file.WriteString(migrationTemplate); err != nil {
+		return "", fmt.Errorf("write migration: %w", err)
 	}
+	return path, nil
 }
@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE order_status_history
+(
+    id          UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    order_id    UUID REFERENCES orders (id)          NOT NULL,
+    from_status INTEGER REFERENCES order_status (id) NOT NULL,
+    to_status   INTEGER REFERENCES order_status (id) NOT NULL,
+    reason      TEXT                                 NOT NULL DEFAULT '',
+    changed_by  TEXT                                 NOT NULL DEFAULT '',
+    created_at  TIMESTAMP WITH TIME ZONE             NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX order_status_history_order_id_idx ON order_status_history (order_id, created_at);
+
+-- +goose Down
+DROP TABLE order_status_history;
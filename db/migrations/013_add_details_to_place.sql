@@ -0,0 +1,22 @@
+-- +goose Up
+ALTER TABLE place ADD COLUMN name TEXT NOT NULL DEFAULT '';
+ALTER TABLE place ADD COLUMN latitude DOUBLE PRECISION NOT NULL DEFAULT 0;
+ALTER TABLE place ADD COLUMN longitude DOUBLE PRECISION NOT NULL DEFAULT 0;
+ALTER TABLE place ADD COLUMN active BOOLEAN NOT NULL DEFAULT TRUE;
+
+CREATE TABLE place_opening_hours
+(
+    place_id    UUID REFERENCES place (id) NOT NULL,
+    day_of_week INT                        NOT NULL CHECK (day_of_week BETWEEN 1 AND 7),
+    opens_at    TIME                       NOT NULL,
+    closes_at   TIME                       NOT NULL,
+    PRIMARY KEY (place_id, day_of_week)
+);
+
+-- +goose Down
+DROP TABLE place_opening_hours;
+
+ALTER TABLE place DROP COLUMN active;
+ALTER TABLE place DROP COLUMN longitude;
+ALTER TABLE place DROP COLUMN latitude;
+ALTER TABLE place DROP COLUMN name;
@@ -0,0 +1,21 @@
+-- +goose Up
+ALTER TABLE place ADD COLUMN timezone TEXT NOT NULL DEFAULT 'Europe/Moscow';
+ALTER TABLE place ADD COLUMN pickup_slot_minutes INT NOT NULL DEFAULT 15 CHECK (pickup_slot_minutes > 0);
+ALTER TABLE place ADD COLUMN pickup_slot_capacity INT NOT NULL DEFAULT 10 CHECK (pickup_slot_capacity > 0);
+
+-- pickup_time had no date part, so it could not describe a pickup on any day
+-- other than the one the order was created on.
+ALTER TABLE orders
+    ALTER COLUMN pickup_time TYPE TIMESTAMP WITH TIME ZONE USING (DATE(created_at) + pickup_time);
+
+CREATE INDEX orders_place_id_pickup_time_idx ON orders (place_id, pickup_time);
+
+-- +goose Down
+DROP INDEX orders_place_id_pickup_time_idx;
+
+ALTER TABLE orders
+    ALTER COLUMN pickup_time TYPE TIME USING pickup_time::time;
+
+ALTER TABLE place DROP COLUMN pickup_slot_capacity;
+ALTER TABLE place DROP COLUMN pickup_slot_minutes;
+ALTER TABLE place DROP COLUMN timezone;
@@ -0,0 +1,13 @@
+-- +goose Up
+-- Menu prices were stored in whole rubles (fractions were truncated on
+-- insert), while order amounts were already in kopecks.
+UPDATE menu_item SET price = price * 100;
+
+ALTER TABLE menu_item ADD COLUMN currency TEXT NOT NULL DEFAULT 'RUB';
+ALTER TABLE orders ADD COLUMN currency TEXT NOT NULL DEFAULT 'RUB';
+
+-- +goose Down
+ALTER TABLE orders DROP COLUMN currency;
+ALTER TABLE menu_item DROP COLUMN currency;
+
+UPDATE menu_item SET price = price / 100;
@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE idempotency_key
+(
+    key          TEXT                     NOT NULL,
+    method       TEXT                     NOT NULL,
+    request_hash BYTEA                    NOT NULL,
+    response     BYTEA,
+    created_at   TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at   TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (key, method)
+);
+
+CREATE INDEX idempotency_key_expires_at_idx ON idempotency_key (expires_at);
+
+-- +goose Down
+DROP TABLE idempotency_key;
@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE outbox
+(
+    id              BIGSERIAL PRIMARY KEY,
+    topic           TEXT                     NOT NULL,
+    key             TEXT                     NOT NULL,
+    event_type      TEXT                     NOT NULL,
+    payload         JSONB                    NOT NULL,
+    attempts        INTEGER                  NOT NULL DEFAULT 0,
+    last_error      TEXT                     NOT NULL DEFAULT '',
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    published_at    TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX outbox_pending_idx ON outbox (next_attempt_at, id) WHERE published_at IS NULL;
+
+-- +goose Down
+DROP TABLE outbox;
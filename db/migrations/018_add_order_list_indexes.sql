@@ -0,0 +1,7 @@
+-- +goose Up
+CREATE INDEX orders_customer_id_created_at_id_idx ON orders (customer_id, created_at DESC, id DESC);
+CREATE INDEX orders_created_at_id_idx ON orders (created_at DESC, id DESC);
+
+-- +goose Down
+DROP INDEX orders_created_at_id_idx;
+DROP INDEX orders_customer_id_created_at_id_idx;
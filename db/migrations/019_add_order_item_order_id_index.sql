@@ -0,0 +1,5 @@
+-- +goose Up
+CREATE INDEX order_item_order_id_idx ON order_item (order_id);
+
+-- +goose Down
+DROP INDEX order_item_order_id_idx;
@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE customer
+    ADD COLUMN email             TEXT    NOT NULL DEFAULT '',
+    ADD COLUMN marketing_consent BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+ALTER TABLE customer
+    DROP COLUMN email,
+    DROP COLUMN marketing_consent;
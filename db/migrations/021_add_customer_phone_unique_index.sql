@@ -0,0 +1,26 @@
+-- +goose Up
+-- Concurrent registrations could create several customers for one phone.
+-- Keep one of them and move the orders of the others to it.
+CREATE TEMPORARY TABLE customer_duplicate AS
+SELECT c.id, k.id AS keep_id
+FROM customer c
+         JOIN (SELECT DISTINCT ON (phone) phone, id
+               FROM customer
+               ORDER BY phone, id) k ON k.phone = c.phone AND k.id <> c.id;
+
+UPDATE orders o
+SET customer_id = d.keep_id
+FROM customer_duplicate d
+WHERE o.customer_id = d.id;
+
+DELETE
+FROM customer c
+    USING customer_duplicate d
+WHERE c.id = d.id;
+
+DROP TABLE customer_duplicate;
+
+CREATE UNIQUE INDEX customer_phone_key ON customer (phone);
+
+-- +goose Down
+DROP INDEX customer_phone_key;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- 1 - customer, 2 - staff, 3 - admin.
+ALTER TABLE customer
+    ADD COLUMN role INTEGER NOT NULL DEFAULT 1 CHECK (role BETWEEN 1 AND 3);
+
+-- +goose Down
+ALTER TABLE customer DROP COLUMN role;
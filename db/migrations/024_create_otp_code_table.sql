@@ -0,0 +1,12 @@
+-- +goose Up
+CREATE TABLE otp_code
+(
+    phone      TEXT PRIMARY KEY,
+    code_hash  BYTEA                    NOT NULL,
+    attempts   INTEGER                  NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- +goose Down
+DROP TABLE otp_code;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- Stored responses belong to the user who sent the request, so that replaying
+-- a key cannot return them to anyone else. Existing keys are not tied to a
+-- user and are dropped; they only live for a day anyway.
+DELETE FROM idempotency_key;
+ALTER TABLE idempotency_key ADD COLUMN user_id TEXT NOT NULL;
+ALTER TABLE idempotency_key DROP CONSTRAINT idempotency_key_pkey;
+ALTER TABLE idempotency_key ADD PRIMARY KEY (user_id, key, method);
+
+-- +goose Down
+DELETE FROM idempotency_key;
+ALTER TABLE idempotency_key DROP CONSTRAINT idempotency_key_pkey;
+ALTER TABLE idempotency_key ADD PRIMARY KEY (key, method);
+ALTER TABLE idempotency_key DROP COLUMN user_id;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- A phone that ran out of attempts cannot request a new code until
+-- locked_until, so the attempt limit cannot be reset by asking for more codes.
+ALTER TABLE otp_code ADD COLUMN locked_until TIMESTAMP WITH TIME ZONE;
+
+-- +goose Down
+ALTER TABLE otp_code DROP COLUMN locked_until;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- Lets the retention sweep find old published messages.
+CREATE INDEX outbox_published_at_idx ON outbox (published_at) WHERE published_at IS NOT NULL;
+
+-- +goose Down
+DROP INDEX outbox_published_at_idx;
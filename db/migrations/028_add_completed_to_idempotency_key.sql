@@ -0,0 +1,8 @@
+-- +goose Up
+-- Set in the transaction of the request that reserved the key, so a key whose
+-- request has committed is never taken over, even when its response could not
+-- be stored.
+ALTER TABLE idempotency_key ADD COLUMN completed BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+ALTER TABLE idempotency_key DROP COLUMN completed;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- The relay checks for older unpublished messages with the same key before
+-- claiming one.
+CREATE INDEX outbox_pending_key_idx ON outbox (key, id) WHERE published_at IS NULL;
+
+-- +goose Down
+DROP INDEX outbox_pending_key_idx;
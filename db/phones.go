@@ -0,0 +1,107 @@
+package db
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+
+	"github.com/pressly/goose/v3"
+)
+
+// normalizePhonesVersion is the version of the Go migration that brings
+// stored phones to E.164. It is written in Go because SQL cannot parse the
+// numbers of every region.
+const normalizePhonesVersion = 22
+
+// PhoneNormalizer brings a phone number to the E.164 format.
+type PhoneNormalizer interface {
+	Normalize(number string) (string, error)
+}
+
+func normalizePhonesMigration(phones PhoneNormalizer) *goose.Migration {
+	return goose.NewGoMigration(normalizePhonesVersion,
+		&goose.GoFunc{RunTx: func(ctx context.Context, tx *sql.Tx) error {
+			return normalizePhones(ctx, tx, phones)
+		}},
+		// Merged customers and original spellings cannot be restored.
+		nil,
+	)
+}
+
+type storedCustomer struct {
+	id, phone  string
+	named      bool
+	normalized string
+}
+
+// normalizePhones rewrites the phones of customers in the E.164 format,
+// reading national numbers as numbers of the default region. Phones that
+// cannot be parsed are kept as they are. Several ways of writing one number
+// become one customer: one is kept, preferring a customer with a name, and
+// the orders of the others are moved to it.
+func normalizePhones(ctx context.Context, tx *sql.Tx, phones PhoneNormalizer) error {
+	rows, err := tx.QueryContext(ctx, `SELECT id, phone, COALESCE(name, '') <> '' FROM customer`)
+	if err != nil {
+		return fmt.Errorf("select customers: %w", err)
+	}
+	defer rows.Close()
+
+	var customers []storedCustomer
+	for rows.Next() {
+		var c storedCustomer
+		if err := rows.Scan(&c.id, &c.phone, &c.named); err != nil {
+			return fmt.Errorf("scan customer: %w", err)
+		}
+		c.normalized = c.phone
+		if normalized, err := phones.Normalize(c.phone); err == nil {
+			c.normalized = normalized
+		}
+		customers = append(customers, c)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("select customers: %w", err)
+	}
+	rows.Close()
+
+	slices.SortFunc(customers, func(a, b storedCustomer) int {
+		if c := cmp.Compare(a.normalized, b.normalized); c != 0 {
+			return c
+		}
+		if a.named != b.named {
+			if a.named {
+				return -1
+			}
+			return 1
+		}
+		return cmp.Compare(a.id, b.id)
+	})
+
+	// Duplicates are deleted before phones change, so that the unique index
+	// on phone never sees two equal ones.
+	var keep []storedCustomer
+	for i, c := range customers {
+		if i > 0 && c.normalized == customers[i-1].normalized {
+			kept := keep[len(keep)-1]
+			if _, err := tx.ExecContext(ctx, `UPDATE orders SET customer_id = $1 WHERE customer_id = $2`, kept.id, c.id); err != nil {
+				return fmt.Errorf("move orders of duplicate customer: %w", err)
+			}
+			if _, err := tx.ExecContext(ctx, `DELETE FROM customer WHERE id = $1`, c.id); err != nil {
+				return fmt.Errorf("delete duplicate customer: %w", err)
+			}
+			continue
+		}
+		keep = append(keep, c)
+	}
+
+	for _, c := range keep {
+		if c.phone == c.normalized {
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, `UPDATE customer SET phone = $1 WHERE id = $2`, c.normalized, c.id); err != nil {
+			return fmt.Errorf("update customer phone: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,1329 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: api/kitchen/kitchen.proto
+
+package kitchen
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _kitchen_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on TicketItem with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TicketItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TicketItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TicketItemMultiError, or
+// nil if none found.
+func (m *TicketItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TicketItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MenuItemId
+
+	// no validation rules for Name
+
+	// no validation rules for Quantity
+
+	if len(errors) > 0 {
+		return TicketItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// TicketItemMultiError is an error wrapping multiple validation errors
+// returned by TicketItem.ValidateAll() if the designated constraints aren't met.
+type TicketItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TicketItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TicketItemMultiError) AllErrors() []error { return m }
+
+// TicketItemValidationError is the validation error returned by
+// TicketItem.Validate if the designated constraints aren't met.
+type TicketItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TicketItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TicketItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TicketItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TicketItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TicketItemValidationError) ErrorName() string { return "TicketItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TicketItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTicketItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TicketItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TicketItemValidationError{}
+
+// Validate checks the field values on Ticket with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Ticket) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Ticket with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TicketMultiError, or nil if none found.
+func (m *Ticket) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Ticket) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for Status
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TicketValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TicketValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TicketValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for PickUp
+
+	if all {
+		switch v := interface{}(m.GetPickupTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TicketValidationError{
+					field:  "PickupTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TicketValidationError{
+					field:  "PickupTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPickupTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TicketValidationError{
+				field:  "PickupTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return TicketMultiError(errors)
+	}
+
+	return nil
+}
+
+// TicketMultiError is an error wrapping multiple validation errors returned by
+// Ticket.ValidateAll() if the designated constraints aren't met.
+type TicketMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TicketMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TicketMultiError) AllErrors() []error { return m }
+
+// TicketValidationError is the validation error returned by Ticket.Validate if
+// the designated constraints aren't met.
+type TicketValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TicketValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TicketValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TicketValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TicketValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TicketValidationError) ErrorName() string { return "TicketValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TicketValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTicket.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TicketValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TicketValidationError{}
+
+// Validate checks the field values on GetQueueRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetQueueRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetQueueRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetQueueRequestMultiError, or nil if none found.
+func (m *GetQueueRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetQueueRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetPlaceId()); err != nil {
+		err = GetQueueRequestValidationError{
+			field:  "PlaceId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetQueueRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetQueueRequest) _validateUuid(uuid string) error {
+	if matched := _kitchen_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetQueueRequestMultiError is an error wrapping multiple validation errors
+// returned by GetQueueRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetQueueRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetQueueRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetQueueRequestMultiError) AllErrors() []error { return m }
+
+// GetQueueRequestValidationError is the validation error returned by
+// GetQueueRequest.Validate if the designated constraints aren't met.
+type GetQueueRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetQueueRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetQueueRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetQueueRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetQueueRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetQueueRequestValidationError) ErrorName() string { return "GetQueueRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetQueueRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetQueueRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetQueueRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetQueueRequestValidationError{}
+
+// Validate checks the field values on GetQueueResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetQueueResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetQueueResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetQueueResponseMultiError, or nil if none found.
+func (m *GetQueueResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetQueueResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTickets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetQueueResponseValidationError{
+						field:  fmt.Sprintf("Tickets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetQueueResponseValidationError{
+						field:  fmt.Sprintf("Tickets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetQueueResponseValidationError{
+					field:  fmt.Sprintf("Tickets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetQueueResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetQueueResponseMultiError is an error wrapping multiple validation errors
+// returned by GetQueueResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetQueueResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetQueueResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetQueueResponseMultiError) AllErrors() []error { return m }
+
+// GetQueueResponseValidationError is the validation error returned by
+// GetQueueResponse.Validate if the designated constraints aren't met.
+type GetQueueResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetQueueResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetQueueResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetQueueResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetQueueResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetQueueResponseValidationError) ErrorName() string { return "GetQueueResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetQueueResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetQueueResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetQueueResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetQueueResponseValidationError{}
+
+// Validate checks the field values on StartPreparingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartPreparingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartPreparingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartPreparingRequestMultiError, or nil if none found.
+func (m *StartPreparingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartPreparingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetOrderId()); err != nil {
+		err = StartPreparingRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StartPreparingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *StartPreparingRequest) _validateUuid(uuid string) error {
+	if matched := _kitchen_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// StartPreparingRequestMultiError is an error wrapping multiple validation
+// errors returned by StartPreparingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StartPreparingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartPreparingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartPreparingRequestMultiError) AllErrors() []error { return m }
+
+// StartPreparingRequestValidationError is the validation error returned by
+// StartPreparingRequest.Validate if the designated constraints aren't met.
+type StartPreparingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartPreparingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartPreparingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartPreparingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartPreparingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartPreparingRequestValidationError) ErrorName() string {
+	return "StartPreparingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartPreparingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartPreparingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartPreparingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartPreparingRequestValidationError{}
+
+// Validate checks the field values on StartPreparingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartPreparingResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartPreparingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartPreparingResponseMultiError, or nil if none found.
+func (m *StartPreparingResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartPreparingResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTicket()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartPreparingResponseValidationError{
+					field:  "Ticket",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartPreparingResponseValidationError{
+					field:  "Ticket",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTicket()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartPreparingResponseValidationError{
+				field:  "Ticket",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StartPreparingResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartPreparingResponseMultiError is an error wrapping multiple validation
+// errors returned by StartPreparingResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StartPreparingResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartPreparingResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartPreparingResponseMultiError) AllErrors() []error { return m }
+
+// StartPreparingResponseValidationError is the validation error returned by
+// StartPreparingResponse.Validate if the designated constraints aren't met.
+type StartPreparingResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartPreparingResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartPreparingResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartPreparingResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartPreparingResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartPreparingResponseValidationError) ErrorName() string {
+	return "StartPreparingResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartPreparingResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartPreparingResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartPreparingResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartPreparingResponseValidationError{}
+
+// Validate checks the field values on MarkReadyRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MarkReadyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkReadyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MarkReadyRequestMultiError, or nil if none found.
+func (m *MarkReadyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkReadyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetOrderId()); err != nil {
+		err = MarkReadyRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MarkReadyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *MarkReadyRequest) _validateUuid(uuid string) error {
+	if matched := _kitchen_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// MarkReadyRequestMultiError is an error wrapping multiple validation errors
+// returned by MarkReadyRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MarkReadyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkReadyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkReadyRequestMultiError) AllErrors() []error { return m }
+
+// MarkReadyRequestValidationError is the validation error returned by
+// MarkReadyRequest.Validate if the designated constraints aren't met.
+type MarkReadyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkReadyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkReadyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkReadyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkReadyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkReadyRequestValidationError) ErrorName() string { return "MarkReadyRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MarkReadyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkReadyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkReadyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkReadyRequestValidationError{}
+
+// Validate checks the field values on MarkReadyResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MarkReadyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkReadyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MarkReadyResponseMultiError, or nil if none found.
+func (m *MarkReadyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkReadyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTicket()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MarkReadyResponseValidationError{
+					field:  "Ticket",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MarkReadyResponseValidationError{
+					field:  "Ticket",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTicket()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MarkReadyResponseValidationError{
+				field:  "Ticket",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MarkReadyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkReadyResponseMultiError is an error wrapping multiple validation errors
+// returned by MarkReadyResponse.ValidateAll() if the designated constraints
+// aren't met.
+type MarkReadyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkReadyResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkReadyResponseMultiError) AllErrors() []error { return m }
+
+// MarkReadyResponseValidationError is the validation error returned by
+// MarkReadyResponse.Validate if the designated constraints aren't met.
+type MarkReadyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkReadyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkReadyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkReadyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkReadyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkReadyResponseValidationError) ErrorName() string {
+	return "MarkReadyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MarkReadyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkReadyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkReadyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkReadyResponseValidationError{}
+
+// Validate checks the field values on WatchQueueRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WatchQueueRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchQueueRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchQueueRequestMultiError, or nil if none found.
+func (m *WatchQueueRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchQueueRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetPlaceId()); err != nil {
+		err = WatchQueueRequestValidationError{
+			field:  "PlaceId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return WatchQueueRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *WatchQueueRequest) _validateUuid(uuid string) error {
+	if matched := _kitchen_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// WatchQueueRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchQueueRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WatchQueueRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchQueueRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchQueueRequestMultiError) AllErrors() []error { return m }
+
+// WatchQueueRequestValidationError is the validation error returned by
+// WatchQueueRequest.Validate if the designated constraints aren't met.
+type WatchQueueRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchQueueRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchQueueRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchQueueRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchQueueRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchQueueRequestValidationError) ErrorName() string {
+	return "WatchQueueRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchQueueRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchQueueRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchQueueRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchQueueRequestValidationError{}
+
+// Validate checks the field values on WatchQueueResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchQueueResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchQueueResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchQueueResponseMultiError, or nil if none found.
+func (m *WatchQueueResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchQueueResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTickets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WatchQueueResponseValidationError{
+						field:  fmt.Sprintf("Tickets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WatchQueueResponseValidationError{
+						field:  fmt.Sprintf("Tickets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WatchQueueResponseValidationError{
+					field:  fmt.Sprintf("Tickets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return WatchQueueResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchQueueResponseMultiError is an error wrapping multiple validation errors
+// returned by WatchQueueResponse.ValidateAll() if the designated constraints
+// aren't met.
+type WatchQueueResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchQueueResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchQueueResponseMultiError) AllErrors() []error { return m }
+
+// WatchQueueResponseValidationError is the validation error returned by
+// WatchQueueResponse.Validate if the designated constraints aren't met.
+type WatchQueueResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchQueueResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchQueueResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchQueueResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchQueueResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchQueueResponseValidationError) ErrorName() string {
+	return "WatchQueueResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchQueueResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchQueueResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchQueueResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchQueueResponseValidationError{}
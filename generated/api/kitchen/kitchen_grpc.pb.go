@@ -0,0 +1,246 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.12
+// source: api/kitchen/kitchen.proto
+
+package kitchen
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// KitchenServiceClient is the client API for KitchenService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type KitchenServiceClient interface {
+	GetQueue(ctx context.Context, in *GetQueueRequest, opts ...grpc.CallOption) (*GetQueueResponse, error)
+	StartPreparing(ctx context.Context, in *StartPreparingRequest, opts ...grpc.CallOption) (*StartPreparingResponse, error)
+	MarkReady(ctx context.Context, in *MarkReadyRequest, opts ...grpc.CallOption) (*MarkReadyResponse, error)
+	// Sends the queue of the place and then the whole queue again after every
+	// change. On the HTTP gateway, send "Accept: text/event-stream" to receive
+	// the updates as Server-Sent Events.
+	WatchQueue(ctx context.Context, in *WatchQueueRequest, opts ...grpc.CallOption) (KitchenService_WatchQueueClient, error)
+}
+
+type kitchenServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewKitchenServiceClient(cc grpc.ClientConnInterface) KitchenServiceClient {
+	return &kitchenServiceClient{cc}
+}
+
+func (c *kitchenServiceClient) GetQueue(ctx context.Context, in *GetQueueRequest, opts ...grpc.CallOption) (*GetQueueResponse, error) {
+	out := new(GetQueueResponse)
+	err := c.cc.Invoke(ctx, "/kitchen.KitchenService/GetQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kitchenServiceClient) StartPreparing(ctx context.Context, in *StartPreparingRequest, opts ...grpc.CallOption) (*StartPreparingResponse, error) {
+	out := new(StartPreparingResponse)
+	err := c.cc.Invoke(ctx, "/kitchen.KitchenService/StartPreparing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kitchenServiceClient) MarkReady(ctx context.Context, in *MarkReadyRequest, opts ...grpc.CallOption) (*MarkReadyResponse, error) {
+	out := new(MarkReadyResponse)
+	err := c.cc.Invoke(ctx, "/kitchen.KitchenService/MarkReady", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kitchenServiceClient) WatchQueue(ctx context.Context, in *WatchQueueRequest, opts ...grpc.CallOption) (KitchenService_WatchQueueClient, error) {
+	stream, err := c.cc.NewStream(ctx, &KitchenService_ServiceDesc.Streams[0], "/kitchen.KitchenService/WatchQueue", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &kitchenServiceWatchQueueClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type KitchenService_WatchQueueClient interface {
+	Recv() (*WatchQueueResponse, error)
+	grpc.ClientStream
+}
+
+type kitchenServiceWatchQueueClient struct {
+	grpc.ClientStream
+}
+
+func (x *kitchenServiceWatchQueueClient) Recv() (*WatchQueueResponse, error) {
+	m := new(WatchQueueResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// KitchenServiceServer is the server API for KitchenService service.
+// All implementations should embed UnimplementedKitchenServiceServer
+// for forward compatibility
+type KitchenServiceServer interface {
+	GetQueue(context.Context, *GetQueueRequest) (*GetQueueResponse, error)
+	StartPreparing(context.Context, *StartPreparingRequest) (*StartPreparingResponse, error)
+	MarkReady(context.Context, *MarkReadyRequest) (*MarkReadyResponse, error)
+	// Sends the queue of the place and then the whole queue again after every
+	// change. On the HTTP gateway, send "Accept: text/event-stream" to receive
+	// the updates as Server-Sent Events.
+	WatchQueue(*WatchQueueRequest, KitchenService_WatchQueueServer) error
+}
+
+// UnimplementedKitchenServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedKitchenServiceServer struct {
+}
+
+func (UnimplementedKitchenServiceServer) GetQueue(context.Context, *GetQueueRequest) (*GetQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueue not implemented")
+}
+func (UnimplementedKitchenServiceServer) StartPreparing(context.Context, *StartPreparingRequest) (*StartPreparingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartPreparing not implemented")
+}
+func (UnimplementedKitchenServiceServer) MarkReady(context.Context, *MarkReadyRequest) (*MarkReadyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkReady not implemented")
+}
+func (UnimplementedKitchenServiceServer) WatchQueue(*WatchQueueRequest, KitchenService_WatchQueueServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchQueue not implemented")
+}
+
+// UnsafeKitchenServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to KitchenServiceServer will
+// result in compilation errors.
+type UnsafeKitchenServiceServer interface {
+	mustEmbedUnimplementedKitchenServiceServer()
+}
+
+func RegisterKitchenServiceServer(s grpc.ServiceRegistrar, srv KitchenServiceServer) {
+	s.RegisterService(&KitchenService_ServiceDesc, srv)
+}
+
+func _KitchenService_GetQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KitchenServiceServer).GetQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kitchen.KitchenService/GetQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KitchenServiceServer).GetQueue(ctx, req.(*GetQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KitchenService_StartPreparing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartPreparingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KitchenServiceServer).StartPreparing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kitchen.KitchenService/StartPreparing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KitchenServiceServer).StartPreparing(ctx, req.(*StartPreparingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KitchenService_MarkReady_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkReadyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KitchenServiceServer).MarkReady(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kitchen.KitchenService/MarkReady",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KitchenServiceServer).MarkReady(ctx, req.(*MarkReadyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KitchenService_WatchQueue_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchQueueRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KitchenServiceServer).WatchQueue(m, &kitchenServiceWatchQueueServer{stream})
+}
+
+type KitchenService_WatchQueueServer interface {
+	Send(*WatchQueueResponse) error
+	grpc.ServerStream
+}
+
+type kitchenServiceWatchQueueServer struct {
+	grpc.ServerStream
+}
+
+func (x *kitchenServiceWatchQueueServer) Send(m *WatchQueueResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// KitchenService_ServiceDesc is the grpc.ServiceDesc for KitchenService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var KitchenService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "kitchen.KitchenService",
+	HandlerType: (*KitchenServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetQueue",
+			Handler:    _KitchenService_GetQueue_Handler,
+		},
+		{
+			MethodName: "StartPreparing",
+			Handler:    _KitchenService_StartPreparing_Handler,
+		},
+		{
+			MethodName: "MarkReady",
+			Handler:    _KitchenService_MarkReady_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchQueue",
+			Handler:       _KitchenService_WatchQueue_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/kitchen/kitchen.proto",
+}
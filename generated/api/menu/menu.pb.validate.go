@@ -210,10 +210,14 @@ func (m *MenuItem) validate(all bool) error {
 
 	// no validation rules for Description
 
-	if m.GetPrice() <= 0 {
+	// no validation rules for Active
+
+	// no validation rules for ImageUrl
+
+	if m.GetPrice() == nil {
 		err := MenuItemValidationError{
 			field:  "Price",
-			reason: "value must be greater than 0",
+			reason: "value is required",
 		}
 		if !all {
 			return err
@@ -221,9 +225,34 @@ func (m *MenuItem) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Active
-
-	// no validation rules for ImageUrl
+	if all {
+		switch v := interface{}(m.GetPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MenuItemValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MenuItemValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MenuItemValidationError{
+				field:  "Price",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
 		return MenuItemMultiError(errors)
@@ -863,10 +892,12 @@ func (m *CreateMenuItemRequest) validate(all bool) error {
 
 	// no validation rules for Description
 
-	if m.GetPrice() <= 0 {
+	// no validation rules for ImageUrl
+
+	if m.GetPrice() == nil {
 		err := CreateMenuItemRequestValidationError{
 			field:  "Price",
-			reason: "value must be greater than 0",
+			reason: "value is required",
 		}
 		if !all {
 			return err
@@ -874,7 +905,34 @@ func (m *CreateMenuItemRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for ImageUrl
+	if all {
+		switch v := interface{}(m.GetPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateMenuItemRequestValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateMenuItemRequestValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateMenuItemRequestValidationError{
+				field:  "Price",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
 		return CreateMenuItemRequestMultiError(errors)
@@ -1154,10 +1212,12 @@ func (m *UpdateMenuItemRequest) validate(all bool) error {
 
 	// no validation rules for Description
 
-	if m.GetPrice() <= 0 {
+	// no validation rules for ImageUrl
+
+	if m.GetPrice() == nil {
 		err := UpdateMenuItemRequestValidationError{
 			field:  "Price",
-			reason: "value must be greater than 0",
+			reason: "value is required",
 		}
 		if !all {
 			return err
@@ -1165,7 +1225,34 @@ func (m *UpdateMenuItemRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for ImageUrl
+	if all {
+		switch v := interface{}(m.GetPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateMenuItemRequestValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateMenuItemRequestValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateMenuItemRequestValidationError{
+				field:  "Price",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
 		return UpdateMenuItemRequestMultiError(errors)
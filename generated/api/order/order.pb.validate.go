@@ -83,15 +83,33 @@ func (m *OrderItem) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetUnitPrice() <= 0 {
-		err := OrderItemValidationError{
-			field:  "UnitPrice",
-			reason: "value must be greater than 0",
+	if all {
+		switch v := interface{}(m.GetUnitPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderItemValidationError{
+					field:  "UnitPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderItemValidationError{
+					field:  "UnitPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetUnitPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderItemValidationError{
+				field:  "UnitPrice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
@@ -237,17 +255,6 @@ func (m *Order) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetTotalAmount() <= 0 {
-		err := OrderValidationError{
-			field:  "TotalAmount",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if len(m.GetItems()) < 1 {
 		err := OrderValidationError{
 			field:  "Items",
@@ -299,6 +306,64 @@ func (m *Order) validate(all bool) error {
 
 	// no validation rules for PickUp
 
+	if all {
+		switch v := interface{}(m.GetPickupTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "PickupTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "PickupTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPickupTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderValidationError{
+				field:  "PickupTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTotalAmount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "TotalAmount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "TotalAmount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTotalAmount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderValidationError{
+				field:  "TotalAmount",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return OrderMultiError(errors)
 	}
@@ -384,6 +449,118 @@ var _ interface {
 	ErrorName() string
 } = OrderValidationError{}
 
+// Validate checks the field values on OrderStatusChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OrderStatusChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderStatusChange with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderStatusChangeMultiError, or nil if none found.
+func (m *OrderStatusChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderStatusChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FromStatus
+
+	// no validation rules for ToStatus
+
+	// no validation rules for Reason
+
+	// no validation rules for ChangedBy
+
+	// no validation rules for ChangedAt
+
+	if len(errors) > 0 {
+		return OrderStatusChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderStatusChangeMultiError is an error wrapping multiple validation errors
+// returned by OrderStatusChange.ValidateAll() if the designated constraints
+// aren't met.
+type OrderStatusChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderStatusChangeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderStatusChangeMultiError) AllErrors() []error { return m }
+
+// OrderStatusChangeValidationError is the validation error returned by
+// OrderStatusChange.Validate if the designated constraints aren't met.
+type OrderStatusChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderStatusChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderStatusChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderStatusChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderStatusChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderStatusChangeValidationError) ErrorName() string {
+	return "OrderStatusChangeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrderStatusChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderStatusChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderStatusChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderStatusChangeValidationError{}
+
 // Validate checks the field values on CreateOrderRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -418,7 +595,17 @@ func (m *CreateOrderRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for RestaurantId
+	if err := m._validateUuid(m.GetRestaurantId()); err != nil {
+		err = CreateOrderRequestValidationError{
+			field:  "RestaurantId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(m.GetItems()) < 1 {
 		err := CreateOrderRequestValidationError{
@@ -467,6 +654,35 @@ func (m *CreateOrderRequest) validate(all bool) error {
 
 	// no validation rules for PickUp
 
+	if all {
+		switch v := interface{}(m.GetPickupTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateOrderRequestValidationError{
+					field:  "PickupTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateOrderRequestValidationError{
+					field:  "PickupTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPickupTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateOrderRequestValidationError{
+				field:  "PickupTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return CreateOrderRequestMultiError(errors)
 	}
@@ -941,9 +1157,29 @@ func (m *ListUserOrdersRequest) validate(all bool) error {
 
 	// no validation rules for UserId
 
-	// no validation rules for Limit
+	if m.GetLimit() < 0 {
+		err := ListUserOrdersRequestValidationError{
+			field:  "Limit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
 
-	// no validation rules for Offset
+	if _, ok := OrderView_name[int32(m.GetView())]; !ok {
+		err := ListUserOrdersRequestValidationError{
+			field:  "View",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
 		return ListUserOrdersRequestMultiError(errors)
@@ -1081,6 +1317,8 @@ func (m *ListUserOrdersResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
 		return ListUserOrdersResponseMultiError(errors)
 	}
@@ -1183,9 +1421,29 @@ func (m *ListOrdersByStatusRequest) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for Limit
+	if m.GetLimit() < 0 {
+		err := ListOrdersByStatusRequestValidationError{
+			field:  "Limit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
 
-	// no validation rules for Offset
+	if _, ok := OrderView_name[int32(m.GetView())]; !ok {
+		err := ListOrdersByStatusRequestValidationError{
+			field:  "View",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
 		return ListOrdersByStatusRequestMultiError(errors)
@@ -1323,6 +1581,8 @@ func (m *ListOrdersByStatusResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
 		return ListOrdersByStatusResponseMultiError(errors)
 	}
@@ -1403,59 +1663,1280 @@ var _ interface {
 	ErrorName() string
 } = ListOrdersByStatusResponseValidationError{}
 
-// Validate checks the field values on CancelOrderRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CancelOrderRequest) Validate() error {
+// Validate checks the field values on OrderFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderFilter) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CancelOrderRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CancelOrderRequestMultiError, or nil if none found.
-func (m *CancelOrderRequest) ValidateAll() error {
+// ValidateAll checks the field values on OrderFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderFilterMultiError, or
+// nil if none found.
+func (m *OrderFilter) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CancelOrderRequest) validate(all bool) error {
+func (m *OrderFilter) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OrderId
+	// no validation rules for CustomerPhone
 
-	// no validation rules for Reason
+	if m.GetPlaceId() != "" {
+
+		if err := m._validateUuid(m.GetPlaceId()); err != nil {
+			err = OrderFilterValidationError{
+				field:  "PlaceId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-	if len(errors) > 0 {
-		return CancelOrderRequestMultiError(errors)
 	}
 
-	return nil
-}
+	for idx, item := range m.GetStatuses() {
+		_, _ = idx, item
 
-// CancelOrderRequestMultiError is an error wrapping multiple validation errors
-// returned by CancelOrderRequest.ValidateAll() if the designated constraints
-// aren't met.
-type CancelOrderRequestMultiError []error
+		if _, ok := OrderStatus_name[int32(item)]; !ok {
+			err := OrderFilterValidationError{
+				field:  fmt.Sprintf("Statuses[%v]", idx),
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m CancelOrderRequestMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
 	}
-	return strings.Join(msgs, "; ")
-}
 
-// AllErrors returns a list of validation violation errors.
+	if all {
+		switch v := interface{}(m.GetCreatedFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderFilterValidationError{
+					field:  "CreatedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderFilterValidationError{
+					field:  "CreatedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderFilterValidationError{
+				field:  "CreatedFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderFilterValidationError{
+					field:  "CreatedTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderFilterValidationError{
+					field:  "CreatedTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderFilterValidationError{
+				field:  "CreatedTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMinTotalAmount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderFilterValidationError{
+					field:  "MinTotalAmount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderFilterValidationError{
+					field:  "MinTotalAmount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMinTotalAmount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderFilterValidationError{
+				field:  "MinTotalAmount",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMaxTotalAmount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderFilterValidationError{
+					field:  "MaxTotalAmount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderFilterValidationError{
+					field:  "MaxTotalAmount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMaxTotalAmount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderFilterValidationError{
+				field:  "MaxTotalAmount",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPickUp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderFilterValidationError{
+					field:  "PickUp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderFilterValidationError{
+					field:  "PickUp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPickUp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderFilterValidationError{
+				field:  "PickUp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OrderFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *OrderFilter) _validateUuid(uuid string) error {
+	if matched := _order_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// OrderFilterMultiError is an error wrapping multiple validation errors
+// returned by OrderFilter.ValidateAll() if the designated constraints aren't met.
+type OrderFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderFilterMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderFilterMultiError) AllErrors() []error { return m }
+
+// OrderFilterValidationError is the validation error returned by
+// OrderFilter.Validate if the designated constraints aren't met.
+type OrderFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderFilterValidationError) ErrorName() string { return "OrderFilterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderFilterValidationError{}
+
+// Validate checks the field values on OrderSort with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderSort) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderSort with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderSortMultiError, or nil
+// if none found.
+func (m *OrderSort) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderSort) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := OrderSortField_name[int32(m.GetField())]; !ok {
+		err := OrderSortValidationError{
+			field:  "Field",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Ascending
+
+	if len(errors) > 0 {
+		return OrderSortMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderSortMultiError is an error wrapping multiple validation errors returned
+// by OrderSort.ValidateAll() if the designated constraints aren't met.
+type OrderSortMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderSortMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderSortMultiError) AllErrors() []error { return m }
+
+// OrderSortValidationError is the validation error returned by
+// OrderSort.Validate if the designated constraints aren't met.
+type OrderSortValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderSortValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderSortValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderSortValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderSortValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderSortValidationError) ErrorName() string { return "OrderSortValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderSortValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderSort.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderSortValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderSortValidationError{}
+
+// Validate checks the field values on SearchOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchOrdersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchOrdersRequestMultiError, or nil if none found.
+func (m *SearchOrdersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchOrdersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchOrdersRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchOrdersRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchOrdersRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSort()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchOrdersRequestValidationError{
+					field:  "Sort",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchOrdersRequestValidationError{
+					field:  "Sort",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSort()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchOrdersRequestValidationError{
+				field:  "Sort",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetLimit() < 0 {
+		err := SearchOrdersRequestValidationError{
+			field:  "Limit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOffset() < 0 {
+		err := SearchOrdersRequestValidationError{
+			field:  "Offset",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := OrderView_name[int32(m.GetView())]; !ok {
+		err := SearchOrdersRequestValidationError{
+			field:  "View",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SearchOrdersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchOrdersRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchOrdersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchOrdersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchOrdersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchOrdersRequestMultiError) AllErrors() []error { return m }
+
+// SearchOrdersRequestValidationError is the validation error returned by
+// SearchOrdersRequest.Validate if the designated constraints aren't met.
+type SearchOrdersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchOrdersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchOrdersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchOrdersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchOrdersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchOrdersRequestValidationError) ErrorName() string {
+	return "SearchOrdersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchOrdersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchOrdersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchOrdersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchOrdersRequestValidationError{}
+
+// Validate checks the field values on SearchOrdersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchOrdersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchOrdersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchOrdersResponseMultiError, or nil if none found.
+func (m *SearchOrdersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchOrdersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchOrdersResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchOrdersResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchOrdersResponseValidationError{
+					field:  fmt.Sprintf("Orders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	if len(errors) > 0 {
+		return SearchOrdersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchOrdersResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchOrdersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchOrdersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchOrdersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchOrdersResponseMultiError) AllErrors() []error { return m }
+
+// SearchOrdersResponseValidationError is the validation error returned by
+// SearchOrdersResponse.Validate if the designated constraints aren't met.
+type SearchOrdersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchOrdersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchOrdersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchOrdersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchOrdersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchOrdersResponseValidationError) ErrorName() string {
+	return "SearchOrdersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchOrdersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchOrdersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchOrdersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchOrdersResponseValidationError{}
+
+// Validate checks the field values on CancelOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelOrderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelOrderRequestMultiError, or nil if none found.
+func (m *CancelOrderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelOrderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return CancelOrderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelOrderRequestMultiError is an error wrapping multiple validation errors
+// returned by CancelOrderRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CancelOrderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelOrderRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
 func (m CancelOrderRequestMultiError) AllErrors() []error { return m }
 
-// CancelOrderRequestValidationError is the validation error returned by
-// CancelOrderRequest.Validate if the designated constraints aren't met.
-type CancelOrderRequestValidationError struct {
+// CancelOrderRequestValidationError is the validation error returned by
+// CancelOrderRequest.Validate if the designated constraints aren't met.
+type CancelOrderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelOrderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelOrderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelOrderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelOrderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelOrderRequestValidationError) ErrorName() string {
+	return "CancelOrderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelOrderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelOrderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelOrderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelOrderRequestValidationError{}
+
+// Validate checks the field values on CancelOrderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelOrderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelOrderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelOrderResponseMultiError, or nil if none found.
+func (m *CancelOrderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelOrderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetOrder()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CancelOrderResponseValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CancelOrderResponseValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrder()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CancelOrderResponseValidationError{
+				field:  "Order",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CancelOrderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelOrderResponseMultiError is an error wrapping multiple validation
+// errors returned by CancelOrderResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CancelOrderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelOrderResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelOrderResponseMultiError) AllErrors() []error { return m }
+
+// CancelOrderResponseValidationError is the validation error returned by
+// CancelOrderResponse.Validate if the designated constraints aren't met.
+type CancelOrderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelOrderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelOrderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelOrderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelOrderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelOrderResponseValidationError) ErrorName() string {
+	return "CancelOrderResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelOrderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelOrderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelOrderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelOrderResponseValidationError{}
+
+// Validate checks the field values on UpdateOrderStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateOrderStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateOrderStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateOrderStatusRequestMultiError, or nil if none found.
+func (m *UpdateOrderStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateOrderStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for NewStatus
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return UpdateOrderStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateOrderStatusRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateOrderStatusRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateOrderStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateOrderStatusRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateOrderStatusRequestMultiError) AllErrors() []error { return m }
+
+// UpdateOrderStatusRequestValidationError is the validation error returned by
+// UpdateOrderStatusRequest.Validate if the designated constraints aren't met.
+type UpdateOrderStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateOrderStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateOrderStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateOrderStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateOrderStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateOrderStatusRequestValidationError) ErrorName() string {
+	return "UpdateOrderStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateOrderStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateOrderStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateOrderStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateOrderStatusRequestValidationError{}
+
+// Validate checks the field values on UpdateOrderStatusResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateOrderStatusResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateOrderStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateOrderStatusResponseMultiError, or nil if none found.
+func (m *UpdateOrderStatusResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateOrderStatusResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetOrder()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateOrderStatusResponseValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateOrderStatusResponseValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrder()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateOrderStatusResponseValidationError{
+				field:  "Order",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateOrderStatusResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateOrderStatusResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateOrderStatusResponse.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateOrderStatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateOrderStatusResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateOrderStatusResponseMultiError) AllErrors() []error { return m }
+
+// UpdateOrderStatusResponseValidationError is the validation error returned by
+// UpdateOrderStatusResponse.Validate if the designated constraints aren't met.
+type UpdateOrderStatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateOrderStatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateOrderStatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateOrderStatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateOrderStatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateOrderStatusResponseValidationError) ErrorName() string {
+	return "UpdateOrderStatusResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateOrderStatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateOrderStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateOrderStatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateOrderStatusResponseValidationError{}
+
+// Validate checks the field values on GetOrderHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetOrderHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOrderHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOrderHistoryRequestMultiError, or nil if none found.
+func (m *GetOrderHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOrderHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetOrderId()); err != nil {
+		err = GetOrderHistoryRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetOrderHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetOrderHistoryRequest) _validateUuid(uuid string) error {
+	if matched := _order_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetOrderHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by GetOrderHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetOrderHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOrderHistoryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOrderHistoryRequestMultiError) AllErrors() []error { return m }
+
+// GetOrderHistoryRequestValidationError is the validation error returned by
+// GetOrderHistoryRequest.Validate if the designated constraints aren't met.
+type GetOrderHistoryRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1463,24 +2944,24 @@ type CancelOrderRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CancelOrderRequestValidationError) Field() string { return e.field }
+func (e GetOrderHistoryRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CancelOrderRequestValidationError) Reason() string { return e.reason }
+func (e GetOrderHistoryRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CancelOrderRequestValidationError) Cause() error { return e.cause }
+func (e GetOrderHistoryRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CancelOrderRequestValidationError) Key() bool { return e.key }
+func (e GetOrderHistoryRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CancelOrderRequestValidationError) ErrorName() string {
-	return "CancelOrderRequestValidationError"
+func (e GetOrderHistoryRequestValidationError) ErrorName() string {
+	return "GetOrderHistoryRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CancelOrderRequestValidationError) Error() string {
+func (e GetOrderHistoryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1492,14 +2973,14 @@ func (e CancelOrderRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCancelOrderRequest.%s: %s%s",
+		"invalid %sGetOrderHistoryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CancelOrderRequestValidationError{}
+var _ error = GetOrderHistoryRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1507,73 +2988,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CancelOrderRequestValidationError{}
+} = GetOrderHistoryRequestValidationError{}
 
-// Validate checks the field values on CancelOrderResponse with the rules
+// Validate checks the field values on GetOrderHistoryResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CancelOrderResponse) Validate() error {
+func (m *GetOrderHistoryResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CancelOrderResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetOrderHistoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CancelOrderResponseMultiError, or nil if none found.
-func (m *CancelOrderResponse) ValidateAll() error {
+// GetOrderHistoryResponseMultiError, or nil if none found.
+func (m *GetOrderHistoryResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CancelOrderResponse) validate(all bool) error {
+func (m *GetOrderHistoryResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetOrder()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CancelOrderResponseValidationError{
-					field:  "Order",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetOrderHistoryResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetOrderHistoryResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CancelOrderResponseValidationError{
-					field:  "Order",
+				return GetOrderHistoryResponseValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetOrder()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CancelOrderResponseValidationError{
-				field:  "Order",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return CancelOrderResponseMultiError(errors)
+		return GetOrderHistoryResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CancelOrderResponseMultiError is an error wrapping multiple validation
-// errors returned by CancelOrderResponse.ValidateAll() if the designated
+// GetOrderHistoryResponseMultiError is an error wrapping multiple validation
+// errors returned by GetOrderHistoryResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CancelOrderResponseMultiError []error
+type GetOrderHistoryResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CancelOrderResponseMultiError) Error() string {
+func (m GetOrderHistoryResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1582,11 +3068,11 @@ func (m CancelOrderResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CancelOrderResponseMultiError) AllErrors() []error { return m }
+func (m GetOrderHistoryResponseMultiError) AllErrors() []error { return m }
 
-// CancelOrderResponseValidationError is the validation error returned by
-// CancelOrderResponse.Validate if the designated constraints aren't met.
-type CancelOrderResponseValidationError struct {
+// GetOrderHistoryResponseValidationError is the validation error returned by
+// GetOrderHistoryResponse.Validate if the designated constraints aren't met.
+type GetOrderHistoryResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1594,24 +3080,24 @@ type CancelOrderResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CancelOrderResponseValidationError) Field() string { return e.field }
+func (e GetOrderHistoryResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CancelOrderResponseValidationError) Reason() string { return e.reason }
+func (e GetOrderHistoryResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CancelOrderResponseValidationError) Cause() error { return e.cause }
+func (e GetOrderHistoryResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CancelOrderResponseValidationError) Key() bool { return e.key }
+func (e GetOrderHistoryResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CancelOrderResponseValidationError) ErrorName() string {
-	return "CancelOrderResponseValidationError"
+func (e GetOrderHistoryResponseValidationError) ErrorName() string {
+	return "GetOrderHistoryResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CancelOrderResponseValidationError) Error() string {
+func (e GetOrderHistoryResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1623,14 +3109,14 @@ func (e CancelOrderResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCancelOrderResponse.%s: %s%s",
+		"invalid %sGetOrderHistoryResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CancelOrderResponseValidationError{}
+var _ error = GetOrderHistoryResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1638,48 +3124,64 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CancelOrderResponseValidationError{}
+} = GetOrderHistoryResponseValidationError{}
 
-// Validate checks the field values on UpdateOrderStatusRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateOrderStatusRequest) Validate() error {
+// Validate checks the field values on WatchOrderRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WatchOrderRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateOrderStatusRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on WatchOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateOrderStatusRequestMultiError, or nil if none found.
-func (m *UpdateOrderStatusRequest) ValidateAll() error {
+// WatchOrderRequestMultiError, or nil if none found.
+func (m *WatchOrderRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateOrderStatusRequest) validate(all bool) error {
+func (m *WatchOrderRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OrderId
-
-	// no validation rules for NewStatus
+	if err := m._validateUuid(m.GetOrderId()); err != nil {
+		err = WatchOrderRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return UpdateOrderStatusRequestMultiError(errors)
+		return WatchOrderRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateOrderStatusRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateOrderStatusRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateOrderStatusRequestMultiError []error
+func (m *WatchOrderRequest) _validateUuid(uuid string) error {
+	if matched := _order_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// WatchOrderRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchOrderRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WatchOrderRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateOrderStatusRequestMultiError) Error() string {
+func (m WatchOrderRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1688,11 +3190,11 @@ func (m UpdateOrderStatusRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateOrderStatusRequestMultiError) AllErrors() []error { return m }
+func (m WatchOrderRequestMultiError) AllErrors() []error { return m }
 
-// UpdateOrderStatusRequestValidationError is the validation error returned by
-// UpdateOrderStatusRequest.Validate if the designated constraints aren't met.
-type UpdateOrderStatusRequestValidationError struct {
+// WatchOrderRequestValidationError is the validation error returned by
+// WatchOrderRequest.Validate if the designated constraints aren't met.
+type WatchOrderRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1700,24 +3202,24 @@ type UpdateOrderStatusRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateOrderStatusRequestValidationError) Field() string { return e.field }
+func (e WatchOrderRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateOrderStatusRequestValidationError) Reason() string { return e.reason }
+func (e WatchOrderRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateOrderStatusRequestValidationError) Cause() error { return e.cause }
+func (e WatchOrderRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateOrderStatusRequestValidationError) Key() bool { return e.key }
+func (e WatchOrderRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateOrderStatusRequestValidationError) ErrorName() string {
-	return "UpdateOrderStatusRequestValidationError"
+func (e WatchOrderRequestValidationError) ErrorName() string {
+	return "WatchOrderRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateOrderStatusRequestValidationError) Error() string {
+func (e WatchOrderRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1729,14 +3231,14 @@ func (e UpdateOrderStatusRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateOrderStatusRequest.%s: %s%s",
+		"invalid %sWatchOrderRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateOrderStatusRequestValidationError{}
+var _ error = WatchOrderRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1744,24 +3246,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateOrderStatusRequestValidationError{}
+} = WatchOrderRequestValidationError{}
 
-// Validate checks the field values on UpdateOrderStatusResponse with the rules
+// Validate checks the field values on WatchOrderResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateOrderStatusResponse) Validate() error {
+func (m *WatchOrderResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateOrderStatusResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on WatchOrderResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateOrderStatusResponseMultiError, or nil if none found.
-func (m *UpdateOrderStatusResponse) ValidateAll() error {
+// WatchOrderResponseMultiError, or nil if none found.
+func (m *WatchOrderResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateOrderStatusResponse) validate(all bool) error {
+func (m *WatchOrderResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1772,7 +3274,7 @@ func (m *UpdateOrderStatusResponse) validate(all bool) error {
 		switch v := interface{}(m.GetOrder()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateOrderStatusResponseValidationError{
+				errors = append(errors, WatchOrderResponseValidationError{
 					field:  "Order",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1780,7 +3282,7 @@ func (m *UpdateOrderStatusResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateOrderStatusResponseValidationError{
+				errors = append(errors, WatchOrderResponseValidationError{
 					field:  "Order",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1789,7 +3291,7 @@ func (m *UpdateOrderStatusResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetOrder()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateOrderStatusResponseValidationError{
+			return WatchOrderResponseValidationError{
 				field:  "Order",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -1798,19 +3300,19 @@ func (m *UpdateOrderStatusResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateOrderStatusResponseMultiError(errors)
+		return WatchOrderResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateOrderStatusResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateOrderStatusResponse.ValidateAll() if the
-// designated constraints aren't met.
-type UpdateOrderStatusResponseMultiError []error
+// WatchOrderResponseMultiError is an error wrapping multiple validation errors
+// returned by WatchOrderResponse.ValidateAll() if the designated constraints
+// aren't met.
+type WatchOrderResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateOrderStatusResponseMultiError) Error() string {
+func (m WatchOrderResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1819,11 +3321,11 @@ func (m UpdateOrderStatusResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateOrderStatusResponseMultiError) AllErrors() []error { return m }
+func (m WatchOrderResponseMultiError) AllErrors() []error { return m }
 
-// UpdateOrderStatusResponseValidationError is the validation error returned by
-// UpdateOrderStatusResponse.Validate if the designated constraints aren't met.
-type UpdateOrderStatusResponseValidationError struct {
+// WatchOrderResponseValidationError is the validation error returned by
+// WatchOrderResponse.Validate if the designated constraints aren't met.
+type WatchOrderResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1831,24 +3333,24 @@ type UpdateOrderStatusResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateOrderStatusResponseValidationError) Field() string { return e.field }
+func (e WatchOrderResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateOrderStatusResponseValidationError) Reason() string { return e.reason }
+func (e WatchOrderResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateOrderStatusResponseValidationError) Cause() error { return e.cause }
+func (e WatchOrderResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateOrderStatusResponseValidationError) Key() bool { return e.key }
+func (e WatchOrderResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateOrderStatusResponseValidationError) ErrorName() string {
-	return "UpdateOrderStatusResponseValidationError"
+func (e WatchOrderResponseValidationError) ErrorName() string {
+	return "WatchOrderResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateOrderStatusResponseValidationError) Error() string {
+func (e WatchOrderResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1860,14 +3362,14 @@ func (e UpdateOrderStatusResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateOrderStatusResponse.%s: %s%s",
+		"invalid %sWatchOrderResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateOrderStatusResponseValidationError{}
+var _ error = WatchOrderResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1875,4 +3377,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateOrderStatusResponseValidationError{}
+} = WatchOrderResponseValidationError{}
@@ -0,0 +1,2014 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: api/place/place.proto
+
+package place
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _place_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on OpeningHours with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OpeningHours) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OpeningHours with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OpeningHoursMultiError, or
+// nil if none found.
+func (m *OpeningHours) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OpeningHours) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetDayOfWeek(); val < 1 || val > 7 {
+		err := OpeningHoursValidationError{
+			field:  "DayOfWeek",
+			reason: "value must be inside range [1, 7]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_OpeningHours_OpensAt_Pattern.MatchString(m.GetOpensAt()) {
+		err := OpeningHoursValidationError{
+			field:  "OpensAt",
+			reason: "value does not match regex pattern \"^([01][0-9]|2[0-3]):[0-5][0-9]$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_OpeningHours_ClosesAt_Pattern.MatchString(m.GetClosesAt()) {
+		err := OpeningHoursValidationError{
+			field:  "ClosesAt",
+			reason: "value does not match regex pattern \"^([01][0-9]|2[0-3]):[0-5][0-9]$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return OpeningHoursMultiError(errors)
+	}
+
+	return nil
+}
+
+// OpeningHoursMultiError is an error wrapping multiple validation errors
+// returned by OpeningHours.ValidateAll() if the designated constraints aren't met.
+type OpeningHoursMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OpeningHoursMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OpeningHoursMultiError) AllErrors() []error { return m }
+
+// OpeningHoursValidationError is the validation error returned by
+// OpeningHours.Validate if the designated constraints aren't met.
+type OpeningHoursValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OpeningHoursValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OpeningHoursValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OpeningHoursValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OpeningHoursValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OpeningHoursValidationError) ErrorName() string { return "OpeningHoursValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OpeningHoursValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOpeningHours.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OpeningHoursValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OpeningHoursValidationError{}
+
+var _OpeningHours_OpensAt_Pattern = regexp.MustCompile("^([01][0-9]|2[0-3]):[0-5][0-9]$")
+
+var _OpeningHours_ClosesAt_Pattern = regexp.MustCompile("^([01][0-9]|2[0-3]):[0-5][0-9]$")
+
+// Validate checks the field values on Place with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Place) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Place with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in PlaceMultiError, or nil if none found.
+func (m *Place) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Place) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := PlaceValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAddress()) < 1 {
+		err := PlaceValidationError{
+			field:  "Address",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLatitude(); val < -90 || val > 90 {
+		err := PlaceValidationError{
+			field:  "Latitude",
+			reason: "value must be inside range [-90, 90]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLongitude(); val < -180 || val > 180 {
+		err := PlaceValidationError{
+			field:  "Longitude",
+			reason: "value must be inside range [-180, 180]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetOpeningHours() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlaceValidationError{
+						field:  fmt.Sprintf("OpeningHours[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlaceValidationError{
+						field:  fmt.Sprintf("OpeningHours[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlaceValidationError{
+					field:  fmt.Sprintf("OpeningHours[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Active
+
+	// no validation rules for Timezone
+
+	if val := m.GetPickupSlotMinutes(); val < 5 || val > 240 {
+		err := PlaceValidationError{
+			field:  "PickupSlotMinutes",
+			reason: "value must be inside range [5, 240]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPickupSlotCapacity() <= 0 {
+		err := PlaceValidationError{
+			field:  "PickupSlotCapacity",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PlaceMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlaceMultiError is an error wrapping multiple validation errors returned by
+// Place.ValidateAll() if the designated constraints aren't met.
+type PlaceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlaceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlaceMultiError) AllErrors() []error { return m }
+
+// PlaceValidationError is the validation error returned by Place.Validate if
+// the designated constraints aren't met.
+type PlaceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlaceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlaceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlaceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlaceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlaceValidationError) ErrorName() string { return "PlaceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PlaceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlace.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlaceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlaceValidationError{}
+
+// Validate checks the field values on PickupSlot with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PickupSlot) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PickupSlot with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PickupSlotMultiError, or
+// nil if none found.
+func (m *PickupSlot) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PickupSlot) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PickupSlotValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PickupSlotValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PickupSlotValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PickupSlotValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PickupSlotValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PickupSlotValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Capacity
+
+	// no validation rules for Remaining
+
+	if len(errors) > 0 {
+		return PickupSlotMultiError(errors)
+	}
+
+	return nil
+}
+
+// PickupSlotMultiError is an error wrapping multiple validation errors
+// returned by PickupSlot.ValidateAll() if the designated constraints aren't met.
+type PickupSlotMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PickupSlotMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PickupSlotMultiError) AllErrors() []error { return m }
+
+// PickupSlotValidationError is the validation error returned by
+// PickupSlot.Validate if the designated constraints aren't met.
+type PickupSlotValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PickupSlotValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PickupSlotValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PickupSlotValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PickupSlotValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PickupSlotValidationError) ErrorName() string { return "PickupSlotValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PickupSlotValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPickupSlot.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PickupSlotValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PickupSlotValidationError{}
+
+// Validate checks the field values on CreatePlaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePlaceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePlaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePlaceRequestMultiError, or nil if none found.
+func (m *CreatePlaceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePlaceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := CreatePlaceRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAddress()) < 1 {
+		err := CreatePlaceRequestValidationError{
+			field:  "Address",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLatitude(); val < -90 || val > 90 {
+		err := CreatePlaceRequestValidationError{
+			field:  "Latitude",
+			reason: "value must be inside range [-90, 90]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLongitude(); val < -180 || val > 180 {
+		err := CreatePlaceRequestValidationError{
+			field:  "Longitude",
+			reason: "value must be inside range [-180, 180]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetOpeningHours() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreatePlaceRequestValidationError{
+						field:  fmt.Sprintf("OpeningHours[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreatePlaceRequestValidationError{
+						field:  fmt.Sprintf("OpeningHours[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreatePlaceRequestValidationError{
+					field:  fmt.Sprintf("OpeningHours[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetTimezone()) < 1 {
+		err := CreatePlaceRequestValidationError{
+			field:  "Timezone",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPickupSlotMinutes(); val < 5 || val > 240 {
+		err := CreatePlaceRequestValidationError{
+			field:  "PickupSlotMinutes",
+			reason: "value must be inside range [5, 240]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPickupSlotCapacity() <= 0 {
+		err := CreatePlaceRequestValidationError{
+			field:  "PickupSlotCapacity",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreatePlaceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePlaceRequestMultiError is an error wrapping multiple validation errors
+// returned by CreatePlaceRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreatePlaceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePlaceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePlaceRequestMultiError) AllErrors() []error { return m }
+
+// CreatePlaceRequestValidationError is the validation error returned by
+// CreatePlaceRequest.Validate if the designated constraints aren't met.
+type CreatePlaceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePlaceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePlaceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePlaceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePlaceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePlaceRequestValidationError) ErrorName() string {
+	return "CreatePlaceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePlaceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePlaceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePlaceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePlaceRequestValidationError{}
+
+// Validate checks the field values on CreatePlaceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePlaceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePlaceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePlaceResponseMultiError, or nil if none found.
+func (m *CreatePlaceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePlaceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPlace()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreatePlaceResponseValidationError{
+					field:  "Place",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreatePlaceResponseValidationError{
+					field:  "Place",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPlace()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreatePlaceResponseValidationError{
+				field:  "Place",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreatePlaceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePlaceResponseMultiError is an error wrapping multiple validation
+// errors returned by CreatePlaceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreatePlaceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePlaceResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePlaceResponseMultiError) AllErrors() []error { return m }
+
+// CreatePlaceResponseValidationError is the validation error returned by
+// CreatePlaceResponse.Validate if the designated constraints aren't met.
+type CreatePlaceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePlaceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePlaceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePlaceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePlaceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePlaceResponseValidationError) ErrorName() string {
+	return "CreatePlaceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePlaceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePlaceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePlaceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePlaceResponseValidationError{}
+
+// Validate checks the field values on GetPlaceRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetPlaceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPlaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPlaceRequestMultiError, or nil if none found.
+func (m *GetPlaceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPlaceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetPlaceId()); err != nil {
+		err = GetPlaceRequestValidationError{
+			field:  "PlaceId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetPlaceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetPlaceRequest) _validateUuid(uuid string) error {
+	if matched := _place_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetPlaceRequestMultiError is an error wrapping multiple validation errors
+// returned by GetPlaceRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetPlaceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPlaceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPlaceRequestMultiError) AllErrors() []error { return m }
+
+// GetPlaceRequestValidationError is the validation error returned by
+// GetPlaceRequest.Validate if the designated constraints aren't met.
+type GetPlaceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPlaceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPlaceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPlaceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPlaceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPlaceRequestValidationError) ErrorName() string { return "GetPlaceRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetPlaceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPlaceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPlaceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPlaceRequestValidationError{}
+
+// Validate checks the field values on GetPlaceResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetPlaceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPlaceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPlaceResponseMultiError, or nil if none found.
+func (m *GetPlaceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPlaceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPlace()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPlaceResponseValidationError{
+					field:  "Place",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPlaceResponseValidationError{
+					field:  "Place",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPlace()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPlaceResponseValidationError{
+				field:  "Place",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetPlaceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPlaceResponseMultiError is an error wrapping multiple validation errors
+// returned by GetPlaceResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetPlaceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPlaceResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPlaceResponseMultiError) AllErrors() []error { return m }
+
+// GetPlaceResponseValidationError is the validation error returned by
+// GetPlaceResponse.Validate if the designated constraints aren't met.
+type GetPlaceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPlaceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPlaceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPlaceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPlaceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPlaceResponseValidationError) ErrorName() string { return "GetPlaceResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetPlaceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPlaceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPlaceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPlaceResponseValidationError{}
+
+// Validate checks the field values on ListPlacesRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListPlacesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPlacesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPlacesRequestMultiError, or nil if none found.
+func (m *ListPlacesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPlacesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ActiveOnly
+
+	if len(errors) > 0 {
+		return ListPlacesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPlacesRequestMultiError is an error wrapping multiple validation errors
+// returned by ListPlacesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListPlacesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPlacesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPlacesRequestMultiError) AllErrors() []error { return m }
+
+// ListPlacesRequestValidationError is the validation error returned by
+// ListPlacesRequest.Validate if the designated constraints aren't met.
+type ListPlacesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPlacesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPlacesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPlacesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPlacesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPlacesRequestValidationError) ErrorName() string {
+	return "ListPlacesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPlacesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPlacesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPlacesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPlacesRequestValidationError{}
+
+// Validate checks the field values on ListPlacesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPlacesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPlacesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPlacesResponseMultiError, or nil if none found.
+func (m *ListPlacesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPlacesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPlaces() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPlacesResponseValidationError{
+						field:  fmt.Sprintf("Places[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPlacesResponseValidationError{
+						field:  fmt.Sprintf("Places[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPlacesResponseValidationError{
+					field:  fmt.Sprintf("Places[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListPlacesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPlacesResponseMultiError is an error wrapping multiple validation errors
+// returned by ListPlacesResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListPlacesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPlacesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPlacesResponseMultiError) AllErrors() []error { return m }
+
+// ListPlacesResponseValidationError is the validation error returned by
+// ListPlacesResponse.Validate if the designated constraints aren't met.
+type ListPlacesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPlacesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPlacesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPlacesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPlacesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPlacesResponseValidationError) ErrorName() string {
+	return "ListPlacesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPlacesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPlacesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPlacesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPlacesResponseValidationError{}
+
+// Validate checks the field values on UpdatePlaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePlaceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePlaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePlaceRequestMultiError, or nil if none found.
+func (m *UpdatePlaceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePlaceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = UpdatePlaceRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := UpdatePlaceRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAddress()) < 1 {
+		err := UpdatePlaceRequestValidationError{
+			field:  "Address",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLatitude(); val < -90 || val > 90 {
+		err := UpdatePlaceRequestValidationError{
+			field:  "Latitude",
+			reason: "value must be inside range [-90, 90]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLongitude(); val < -180 || val > 180 {
+		err := UpdatePlaceRequestValidationError{
+			field:  "Longitude",
+			reason: "value must be inside range [-180, 180]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetOpeningHours() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdatePlaceRequestValidationError{
+						field:  fmt.Sprintf("OpeningHours[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdatePlaceRequestValidationError{
+						field:  fmt.Sprintf("OpeningHours[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdatePlaceRequestValidationError{
+					field:  fmt.Sprintf("OpeningHours[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Active
+
+	if utf8.RuneCountInString(m.GetTimezone()) < 1 {
+		err := UpdatePlaceRequestValidationError{
+			field:  "Timezone",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPickupSlotMinutes(); val < 5 || val > 240 {
+		err := UpdatePlaceRequestValidationError{
+			field:  "PickupSlotMinutes",
+			reason: "value must be inside range [5, 240]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPickupSlotCapacity() <= 0 {
+		err := UpdatePlaceRequestValidationError{
+			field:  "PickupSlotCapacity",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdatePlaceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdatePlaceRequest) _validateUuid(uuid string) error {
+	if matched := _place_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdatePlaceRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdatePlaceRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdatePlaceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePlaceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePlaceRequestMultiError) AllErrors() []error { return m }
+
+// UpdatePlaceRequestValidationError is the validation error returned by
+// UpdatePlaceRequest.Validate if the designated constraints aren't met.
+type UpdatePlaceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePlaceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePlaceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePlaceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePlaceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePlaceRequestValidationError) ErrorName() string {
+	return "UpdatePlaceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePlaceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePlaceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePlaceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePlaceRequestValidationError{}
+
+// Validate checks the field values on UpdatePlaceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePlaceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePlaceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePlaceResponseMultiError, or nil if none found.
+func (m *UpdatePlaceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePlaceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPlace()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdatePlaceResponseValidationError{
+					field:  "Place",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdatePlaceResponseValidationError{
+					field:  "Place",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPlace()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdatePlaceResponseValidationError{
+				field:  "Place",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdatePlaceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePlaceResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdatePlaceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdatePlaceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePlaceResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePlaceResponseMultiError) AllErrors() []error { return m }
+
+// UpdatePlaceResponseValidationError is the validation error returned by
+// UpdatePlaceResponse.Validate if the designated constraints aren't met.
+type UpdatePlaceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePlaceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePlaceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePlaceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePlaceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePlaceResponseValidationError) ErrorName() string {
+	return "UpdatePlaceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePlaceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePlaceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePlaceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePlaceResponseValidationError{}
+
+// Validate checks the field values on ListAvailablePickupSlotsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAvailablePickupSlotsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAvailablePickupSlotsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListAvailablePickupSlotsRequestMultiError, or nil if none found.
+func (m *ListAvailablePickupSlotsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAvailablePickupSlotsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetPlaceId()); err != nil {
+		err = ListAvailablePickupSlotsRequestValidationError{
+			field:  "PlaceId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ListAvailablePickupSlotsRequest_Date_Pattern.MatchString(m.GetDate()) {
+		err := ListAvailablePickupSlotsRequestValidationError{
+			field:  "Date",
+			reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListAvailablePickupSlotsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListAvailablePickupSlotsRequest) _validateUuid(uuid string) error {
+	if matched := _place_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListAvailablePickupSlotsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListAvailablePickupSlotsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListAvailablePickupSlotsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAvailablePickupSlotsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAvailablePickupSlotsRequestMultiError) AllErrors() []error { return m }
+
+// ListAvailablePickupSlotsRequestValidationError is the validation error
+// returned by ListAvailablePickupSlotsRequest.Validate if the designated
+// constraints aren't met.
+type ListAvailablePickupSlotsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAvailablePickupSlotsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAvailablePickupSlotsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAvailablePickupSlotsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAvailablePickupSlotsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAvailablePickupSlotsRequestValidationError) ErrorName() string {
+	return "ListAvailablePickupSlotsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAvailablePickupSlotsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAvailablePickupSlotsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAvailablePickupSlotsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAvailablePickupSlotsRequestValidationError{}
+
+var _ListAvailablePickupSlotsRequest_Date_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+// Validate checks the field values on ListAvailablePickupSlotsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListAvailablePickupSlotsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAvailablePickupSlotsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListAvailablePickupSlotsResponseMultiError, or nil if none found.
+func (m *ListAvailablePickupSlotsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAvailablePickupSlotsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSlots() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAvailablePickupSlotsResponseValidationError{
+						field:  fmt.Sprintf("Slots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAvailablePickupSlotsResponseValidationError{
+						field:  fmt.Sprintf("Slots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAvailablePickupSlotsResponseValidationError{
+					field:  fmt.Sprintf("Slots[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListAvailablePickupSlotsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAvailablePickupSlotsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ListAvailablePickupSlotsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListAvailablePickupSlotsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAvailablePickupSlotsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAvailablePickupSlotsResponseMultiError) AllErrors() []error { return m }
+
+// ListAvailablePickupSlotsResponseValidationError is the validation error
+// returned by ListAvailablePickupSlotsResponse.Validate if the designated
+// constraints aren't met.
+type ListAvailablePickupSlotsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAvailablePickupSlotsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAvailablePickupSlotsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAvailablePickupSlotsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAvailablePickupSlotsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAvailablePickupSlotsResponseValidationError) ErrorName() string {
+	return "ListAvailablePickupSlotsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAvailablePickupSlotsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAvailablePickupSlotsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAvailablePickupSlotsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAvailablePickupSlotsResponseValidationError{}
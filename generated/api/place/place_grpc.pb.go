@@ -0,0 +1,248 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.12
+// source: api/place/place.proto
+
+package place
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PlaceServiceClient is the client API for PlaceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PlaceServiceClient interface {
+	CreatePlace(ctx context.Context, in *CreatePlaceRequest, opts ...grpc.CallOption) (*CreatePlaceResponse, error)
+	GetPlace(ctx context.Context, in *GetPlaceRequest, opts ...grpc.CallOption) (*GetPlaceResponse, error)
+	ListPlaces(ctx context.Context, in *ListPlacesRequest, opts ...grpc.CallOption) (*ListPlacesResponse, error)
+	UpdatePlace(ctx context.Context, in *UpdatePlaceRequest, opts ...grpc.CallOption) (*UpdatePlaceResponse, error)
+	ListAvailablePickupSlots(ctx context.Context, in *ListAvailablePickupSlotsRequest, opts ...grpc.CallOption) (*ListAvailablePickupSlotsResponse, error)
+}
+
+type placeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPlaceServiceClient(cc grpc.ClientConnInterface) PlaceServiceClient {
+	return &placeServiceClient{cc}
+}
+
+func (c *placeServiceClient) CreatePlace(ctx context.Context, in *CreatePlaceRequest, opts ...grpc.CallOption) (*CreatePlaceResponse, error) {
+	out := new(CreatePlaceResponse)
+	err := c.cc.Invoke(ctx, "/place.PlaceService/CreatePlace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *placeServiceClient) GetPlace(ctx context.Context, in *GetPlaceRequest, opts ...grpc.CallOption) (*GetPlaceResponse, error) {
+	out := new(GetPlaceResponse)
+	err := c.cc.Invoke(ctx, "/place.PlaceService/GetPlace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *placeServiceClient) ListPlaces(ctx context.Context, in *ListPlacesRequest, opts ...grpc.CallOption) (*ListPlacesResponse, error) {
+	out := new(ListPlacesResponse)
+	err := c.cc.Invoke(ctx, "/place.PlaceService/ListPlaces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *placeServiceClient) UpdatePlace(ctx context.Context, in *UpdatePlaceRequest, opts ...grpc.CallOption) (*UpdatePlaceResponse, error) {
+	out := new(UpdatePlaceResponse)
+	err := c.cc.Invoke(ctx, "/place.PlaceService/UpdatePlace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *placeServiceClient) ListAvailablePickupSlots(ctx context.Context, in *ListAvailablePickupSlotsRequest, opts ...grpc.CallOption) (*ListAvailablePickupSlotsResponse, error) {
+	out := new(ListAvailablePickupSlotsResponse)
+	err := c.cc.Invoke(ctx, "/place.PlaceService/ListAvailablePickupSlots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PlaceServiceServer is the server API for PlaceService service.
+// All implementations should embed UnimplementedPlaceServiceServer
+// for forward compatibility
+type PlaceServiceServer interface {
+	CreatePlace(context.Context, *CreatePlaceRequest) (*CreatePlaceResponse, error)
+	GetPlace(context.Context, *GetPlaceRequest) (*GetPlaceResponse, error)
+	ListPlaces(context.Context, *ListPlacesRequest) (*ListPlacesResponse, error)
+	UpdatePlace(context.Context, *UpdatePlaceRequest) (*UpdatePlaceResponse, error)
+	ListAvailablePickupSlots(context.Context, *ListAvailablePickupSlotsRequest) (*ListAvailablePickupSlotsResponse, error)
+}
+
+// UnimplementedPlaceServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedPlaceServiceServer struct {
+}
+
+func (UnimplementedPlaceServiceServer) CreatePlace(context.Context, *CreatePlaceRequest) (*CreatePlaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePlace not implemented")
+}
+func (UnimplementedPlaceServiceServer) GetPlace(context.Context, *GetPlaceRequest) (*GetPlaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlace not implemented")
+}
+func (UnimplementedPlaceServiceServer) ListPlaces(context.Context, *ListPlacesRequest) (*ListPlacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlaces not implemented")
+}
+func (UnimplementedPlaceServiceServer) UpdatePlace(context.Context, *UpdatePlaceRequest) (*UpdatePlaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePlace not implemented")
+}
+func (UnimplementedPlaceServiceServer) ListAvailablePickupSlots(context.Context, *ListAvailablePickupSlotsRequest) (*ListAvailablePickupSlotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAvailablePickupSlots not implemented")
+}
+
+// UnsafePlaceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PlaceServiceServer will
+// result in compilation errors.
+type UnsafePlaceServiceServer interface {
+	mustEmbedUnimplementedPlaceServiceServer()
+}
+
+func RegisterPlaceServiceServer(s grpc.ServiceRegistrar, srv PlaceServiceServer) {
+	s.RegisterService(&PlaceService_ServiceDesc, srv)
+}
+
+func _PlaceService_CreatePlace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePlaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaceServiceServer).CreatePlace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/place.PlaceService/CreatePlace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaceServiceServer).CreatePlace(ctx, req.(*CreatePlaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaceService_GetPlace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaceServiceServer).GetPlace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/place.PlaceService/GetPlace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaceServiceServer).GetPlace(ctx, req.(*GetPlaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaceService_ListPlaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaceServiceServer).ListPlaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/place.PlaceService/ListPlaces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaceServiceServer).ListPlaces(ctx, req.(*ListPlacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaceService_UpdatePlace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePlaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaceServiceServer).UpdatePlace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/place.PlaceService/UpdatePlace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaceServiceServer).UpdatePlace(ctx, req.(*UpdatePlaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaceService_ListAvailablePickupSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAvailablePickupSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaceServiceServer).ListAvailablePickupSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/place.PlaceService/ListAvailablePickupSlots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaceServiceServer).ListAvailablePickupSlots(ctx, req.(*ListAvailablePickupSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PlaceService_ServiceDesc is the grpc.ServiceDesc for PlaceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PlaceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "place.PlaceService",
+	HandlerType: (*PlaceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePlace",
+			Handler:    _PlaceService_CreatePlace_Handler,
+		},
+		{
+			MethodName: "GetPlace",
+			Handler:    _PlaceService_GetPlace_Handler,
+		},
+		{
+			MethodName: "ListPlaces",
+			Handler:    _PlaceService_ListPlaces_Handler,
+		},
+		{
+			MethodName: "UpdatePlace",
+			Handler:    _PlaceService_UpdatePlace_Handler,
+		},
+		{
+			MethodName: "ListAvailablePickupSlots",
+			Handler:    _PlaceService_ListAvailablePickupSlots_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/place/place.proto",
+}
@@ -0,0 +1,301 @@
+// Package config loads the configuration of the service from an optional
+// YAML file and environment variables, which take precedence.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/Tortik3000/service-order/pkg/phone"
+)
+
+// Config is the configuration of the service. Fields with an env tag can be
+// overridden by that environment variable; lists are comma-separated.
+// Fields tagged secret are redacted by Redacted.
+type Config struct {
+	GRPC        GRPC        `yaml:"grpc"`
+	HTTP        HTTP        `yaml:"http"`
+	Database    Database    `yaml:"database"`
+	Kafka       Kafka       `yaml:"kafka"`
+	Log         Log         `yaml:"log"`
+	Auth        Auth        `yaml:"auth"`
+	Phone       Phone       `yaml:"phone"`
+	Outbox      Outbox      `yaml:"outbox"`
+	Idempotency Idempotency `yaml:"idempotency"`
+	Health      Health      `yaml:"health"`
+	Features    Features    `yaml:"features"`
+	Timeouts    Timeouts    `yaml:"timeouts"`
+}
+
+type GRPC struct {
+	Addr string `yaml:"addr" env:"GRPC_ADDR"`
+}
+
+type HTTP struct {
+	Addr string `yaml:"addr" env:"HTTP_ADDR"`
+	// GatewayTarget is the address the gateway dials the gRPC server at.
+	GatewayTarget string `yaml:"gateway_target" env:"HTTP_GATEWAY_TARGET"`
+}
+
+type Database struct {
+	DSN             string        `yaml:"dsn" env:"DATABASE_URL" secret:"true"`
+	MaxConns        int32         `yaml:"max_conns" env:"DATABASE_MAX_CONNS"`
+	MinConns        int32         `yaml:"min_conns" env:"DATABASE_MIN_CONNS"`
+	MaxConnLifetime time.Duration `yaml:"max_conn_lifetime" env:"DATABASE_MAX_CONN_LIFETIME"`
+	MaxConnIdleTime time.Duration `yaml:"max_conn_idle_time" env:"DATABASE_MAX_CONN_IDLE_TIME"`
+	// HealthCheckPeriod is how often idle connections are checked.
+	HealthCheckPeriod time.Duration `yaml:"health_check_period" env:"DATABASE_HEALTH_CHECK_PERIOD"`
+	// StatementTimeout is the statement_timeout of every connection; zero
+	// turns it off.
+	StatementTimeout time.Duration `yaml:"statement_timeout" env:"DATABASE_STATEMENT_TIMEOUT"`
+	// TxMaxAttempts is how many times a transaction aborted by a conflict or
+	// a lost connection is run at most.
+	TxMaxAttempts int32 `yaml:"tx_max_attempts" env:"DATABASE_TX_MAX_ATTEMPTS"`
+}
+
+type Kafka struct {
+	Brokers  []string `yaml:"brokers" env:"KAFKA_BROKERS"`
+	ClientID string   `yaml:"client_id" env:"KAFKA_CLIENT_ID"`
+	// TLS connects to the brokers over TLS with the system roots.
+	TLS bool `yaml:"tls" env:"KAFKA_TLS"`
+	// SASLMechanism is empty, PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512.
+	SASLMechanism string `yaml:"sasl_mechanism" env:"KAFKA_SASL_MECHANISM"`
+	SASLUsername  string `yaml:"sasl_username" env:"KAFKA_SASL_USERNAME"`
+	SASLPassword  string `yaml:"sasl_password" env:"KAFKA_SASL_PASSWORD" secret:"true"`
+}
+
+type Log struct {
+	// Level is one of debug, info, warn and error.
+	Level string `yaml:"level" env:"LOG_LEVEL"`
+	// Format is json or console.
+	Format string `yaml:"format" env:"LOG_FORMAT"`
+}
+
+type Auth struct {
+	TokenSecret     string        `yaml:"token_secret" env:"AUTH_TOKEN_SECRET" secret:"true"`
+	AccessTokenTTL  time.Duration `yaml:"access_token_ttl" env:"AUTH_ACCESS_TOKEN_TTL"`
+	RefreshTokenTTL time.Duration `yaml:"refresh_token_ttl" env:"AUTH_REFRESH_TOKEN_TTL"`
+	// OtpSecret keys the hashes of stored one-time codes. It must differ
+	// from TokenSecret.
+	OtpSecret string `yaml:"otp_secret" env:"AUTH_OTP_SECRET" secret:"true"`
+}
+
+type Phone struct {
+	DefaultRegion string `yaml:"default_region" env:"PHONE_DEFAULT_REGION"`
+}
+
+type Outbox struct {
+	PollInterval time.Duration `yaml:"poll_interval" env:"OUTBOX_POLL_INTERVAL"`
+	BatchSize    int32         `yaml:"batch_size" env:"OUTBOX_BATCH_SIZE"`
+	// Retention is how long published messages are kept.
+	Retention     time.Duration `yaml:"retention" env:"OUTBOX_RETENTION"`
+	SweepInterval time.Duration `yaml:"sweep_interval" env:"OUTBOX_SWEEP_INTERVAL"`
+}
+
+type Idempotency struct {
+	SweepInterval time.Duration `yaml:"sweep_interval" env:"IDEMPOTENCY_SWEEP_INTERVAL"`
+}
+
+type Health struct {
+	// CheckInterval is how often the readiness reported by the gRPC health
+	// service is updated.
+	CheckInterval time.Duration `yaml:"check_interval" env:"HEALTH_CHECK_INTERVAL"`
+	// MaxOutboxLag is how long an event may wait in the outbox before the
+	// service is not ready; zero turns the check off.
+	MaxOutboxLag time.Duration `yaml:"max_outbox_lag" env:"HEALTH_MAX_OUTBOX_LAG"`
+}
+
+type Features struct {
+	// OutboxRelay publishes order events to Kafka. Replicas that only
+	// serve requests can turn it off.
+	OutboxRelay bool `yaml:"outbox_relay" env:"FEATURE_OUTBOX_RELAY"`
+	// OutboxSweeper deletes published outbox messages after the retention
+	// period.
+	OutboxSweeper bool `yaml:"outbox_sweeper" env:"FEATURE_OUTBOX_SWEEPER"`
+	// IdempotencySweeper deletes expired idempotency keys.
+	IdempotencySweeper bool `yaml:"idempotency_sweeper" env:"FEATURE_IDEMPOTENCY_SWEEPER"`
+	// Reflection registers the gRPC reflection service.
+	Reflection bool `yaml:"reflection" env:"FEATURE_GRPC_REFLECTION"`
+}
+
+type Timeouts struct {
+	Shutdown       time.Duration `yaml:"shutdown" env:"SHUTDOWN_TIMEOUT"`
+	HTTPReadHeader time.Duration `yaml:"http_read_header" env:"HTTP_READ_HEADER_TIMEOUT"`
+}
+
+// Default returns the configuration used for everything that is not set.
+// The database DSN and the auth secrets have no defaults.
+func Default() Config {
+	return Config{
+		GRPC: GRPC{Addr: ":50051"},
+		HTTP: HTTP{
+			Addr:          ":8081",
+			GatewayTarget: "localhost:50051",
+		},
+		Database: Database{
+			MaxConns:          10,
+			MinConns:          0,
+			MaxConnLifetime:   time.Hour,
+			MaxConnIdleTime:   30 * time.Minute,
+			HealthCheckPeriod: time.Minute,
+			StatementTimeout:  30 * time.Second,
+			TxMaxAttempts:     3,
+		},
+		Kafka: Kafka{
+			Brokers:  []string{"kafka:29092"},
+			ClientID: "service-order",
+		},
+		Log: Log{
+			Level:  "info",
+			Format: "json",
+		},
+		Auth: Auth{
+			AccessTokenTTL:  15 * time.Minute,
+			RefreshTokenTTL: 30 * 24 * time.Hour,
+		},
+		Phone: Phone{DefaultRegion: "RU"},
+		Outbox: Outbox{
+			PollInterval:  time.Second,
+			BatchSize:     100,
+			Retention:     7 * 24 * time.Hour,
+			SweepInterval: time.Hour,
+		},
+		Idempotency: Idempotency{SweepInterval: time.Hour},
+		Health: Health{
+			CheckInterval: 5 * time.Second,
+			MaxOutboxLag:  5 * time.Minute,
+		},
+		Features: Features{
+			OutboxRelay:        true,
+			OutboxSweeper:      true,
+			IdempotencySweeper: true,
+			Reflection:         true,
+		},
+		Timeouts: Timeouts{
+			Shutdown:       5 * time.Second,
+			HTTPReadHeader: 10 * time.Second,
+		},
+	}
+}
+
+// Load reads the YAML file at path over the defaults, unless path is empty,
+// applies the environment overrides and validates the result.
+func Load(path string) (Config, error) {
+	cfg, err := read(path)
+	if err != nil {
+		return Config{}, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return Config{}, fmt.Errorf("invalid config: %w", err)
+	}
+
+	return cfg, nil
+}
+
+// LoadMigrations is like Load, but only checks the sections migrations
+// need: the database and the phone region used to normalize stored phones.
+func LoadMigrations(path string) (Config, error) {
+	cfg, err := read(path)
+	if err != nil {
+		return Config{}, err
+	}
+
+	if cfg.Database.DSN == "" {
+		return Config{}, errors.New("invalid config: database.dsn is required")
+	}
+	if _, err := phone.NewParser(cfg.Phone.DefaultRegion); err != nil {
+		return Config{}, fmt.Errorf("invalid config: phone.default_region: %w", err)
+	}
+
+	return cfg, nil
+}
+
+func read(path string) (Config, error) {
+	cfg := Default()
+
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return Config{}, fmt.Errorf("read config file: %w", err)
+		}
+		if err := yaml.Unmarshal(data, &cfg); err != nil {
+			return Config{}, fmt.Errorf("parse config file %s: %w", path, err)
+		}
+	}
+
+	if err := applyEnv(&cfg, os.LookupEnv); err != nil {
+		return Config{}, err
+	}
+
+	return cfg, nil
+}
+
+// Validate reports every problem with the configuration at once.
+func (c Config) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+
+	check(c.GRPC.Addr != "", "grpc.addr is required")
+	check(c.HTTP.Addr != "", "http.addr is required")
+	check(c.HTTP.GatewayTarget != "", "http.gateway_target is required")
+
+	check(c.Database.DSN != "", "database.dsn is required")
+	check(c.Database.MaxConns > 0, "database.max_conns must be positive")
+	check(c.Database.MinConns >= 0 && c.Database.MinConns <= c.Database.MaxConns,
+		"database.min_conns must be between 0 and database.max_conns")
+	check(c.Database.HealthCheckPeriod > 0, "database.health_check_period must be positive")
+	check(c.Database.StatementTimeout >= 0, "database.statement_timeout must not be negative")
+	check(c.Database.TxMaxAttempts > 0, "database.tx_max_attempts must be positive")
+
+	check(len(c.Kafka.Brokers) > 0, "kafka.brokers is required")
+	switch c.Kafka.SASLMechanism {
+	case "":
+	case "PLAIN", "SCRAM-SHA-256", "SCRAM-SHA-512":
+		check(c.Kafka.SASLUsername != "", "kafka.sasl_username is required with kafka.sasl_mechanism")
+	default:
+		check(false, "kafka.sasl_mechanism must be PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512, got %q", c.Kafka.SASLMechanism)
+	}
+
+	switch c.Log.Level {
+	case "debug", "info", "warn", "error":
+	default:
+		check(false, "log.level must be debug, info, warn or error, got %q", c.Log.Level)
+	}
+	check(c.Log.Format == "json" || c.Log.Format == "console",
+		"log.format must be json or console, got %q", c.Log.Format)
+
+	check(len(c.Auth.TokenSecret) >= 16, "auth.token_secret must be at least 16 bytes long")
+	check(len(c.Auth.OtpSecret) >= 16, "auth.otp_secret must be at least 16 bytes long")
+	check(c.Auth.OtpSecret != c.Auth.TokenSecret, "auth.otp_secret must differ from auth.token_secret")
+	check(c.Auth.AccessTokenTTL > 0, "auth.access_token_ttl must be positive")
+	check(c.Auth.RefreshTokenTTL >= c.Auth.AccessTokenTTL,
+		"auth.refresh_token_ttl must not be shorter than auth.access_token_ttl")
+
+	if _, err := phone.NewParser(c.Phone.DefaultRegion); err != nil {
+		check(false, "phone.default_region: %v", err)
+	}
+
+	check(c.Outbox.PollInterval > 0, "outbox.poll_interval must be positive")
+	check(c.Outbox.BatchSize > 0, "outbox.batch_size must be positive")
+	check(c.Outbox.Retention > 0, "outbox.retention must be positive")
+	check(c.Outbox.SweepInterval > 0, "outbox.sweep_interval must be positive")
+
+	check(c.Idempotency.SweepInterval > 0, "idempotency.sweep_interval must be positive")
+
+	check(c.Health.CheckInterval > 0, "health.check_interval must be positive")
+	check(c.Health.MaxOutboxLag >= 0, "health.max_outbox_lag must not be negative")
+
+	check(c.Timeouts.Shutdown > 0, "timeouts.shutdown must be positive")
+	check(c.Timeouts.HTTPReadHeader > 0, "timeouts.http_read_header must be positive")
+
+	return errors.Join(errs...)
+}
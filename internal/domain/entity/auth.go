@@ -0,0 +1,79 @@
+package entity
+
+import (
+	"context"
+	"time"
+)
+
+// Role decides which RPCs a user may call. Values are stored in the
+// customer table and match the Role enum of the API.
+type Role int32
+
+const (
+	RoleUnspecified Role = iota
+	RoleCustomer
+	RoleStaff
+	RoleAdmin
+)
+
+var roleNames = map[Role]string{
+	RoleUnspecified: "UNSPECIFIED",
+	RoleCustomer:    "CUSTOMER",
+	RoleStaff:       "STAFF",
+	RoleAdmin:       "ADMIN",
+}
+
+func (r Role) String() string {
+	if name, ok := roleNames[r]; ok {
+		return name
+	}
+	return "UNKNOWN"
+}
+
+// Principal is the authenticated caller of a request.
+type Principal struct {
+	UserID string
+	Role   Role
+}
+
+type principalKey struct{}
+
+func ContextWithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(Principal)
+	return principal, ok
+}
+
+// CheckUserAccess allows the caller to access the data of the user: its own
+// data, or anyone's for staff and admins.
+func CheckUserAccess(ctx context.Context, userID string) error {
+	principal, ok := PrincipalFromContext(ctx)
+	if ok && (principal.Role >= RoleStaff || principal.UserID == userID) {
+		return nil
+	}
+	return NewPermissionDeniedError("ACCESS_DENIED", "access to data of another user is denied")
+}
+
+// OtpCode is a one-time password sent to a phone. Only a keyed hash of the
+// code is stored.
+type OtpCode struct {
+	Phone     string
+	CodeHash  []byte
+	Attempts  int32
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	// LockedUntil is set when the attempts ran out; no new code is sent to
+	// the phone before it.
+	LockedUntil time.Time
+}
+
+// Tokens are issued to a user after logging in. The access token
+// authenticates requests; the refresh token only gets new tokens.
+type Tokens struct {
+	AccessToken          string
+	AccessTokenExpiresAt time.Time
+	RefreshToken         string
+}
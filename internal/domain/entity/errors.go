@@ -1,7 +1,126 @@
 package entity
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
+// Error kinds. Every domain error matches exactly one of them with errors.Is,
+// which is what the transport layer uses to pick a status code.
 var (
-	ErrNotFound = errors.New("not found")
+	ErrNotFound           = errors.New("not found")
+	ErrAlreadyExists      = errors.New("already exists")
+	ErrInvalidArgument    = errors.New("invalid argument")
+	ErrFailedPrecondition = errors.New("failed precondition")
+	ErrConflict           = errors.New("conflict")
+	ErrUnauthenticated    = errors.New("unauthenticated")
+	ErrPermissionDenied   = errors.New("permission denied")
+	ErrResourceExhausted  = errors.New("resource exhausted")
 )
+
+// ErrOrderStatusChanged is returned when an order's status was changed
+// concurrently between reading it and applying a transition.
+var ErrOrderStatusChanged = NewConflictError("ORDER_STATUS_CHANGED", "order status was changed concurrently")
+
+// Violation describes a single problem with a request: a bad field for
+// invalid arguments or an unmet condition for failed preconditions.
+type Violation struct {
+	Subject     string
+	Description string
+}
+
+// Error is a domain error of one of the kinds above. Reason is a stable,
+// machine-readable identifier such as "ORDER_NOT_FOUND"; Message is safe to
+// show to API clients.
+type Error struct {
+	Kind       error
+	Reason     string
+	Message    string
+	Metadata   map[string]string
+	Violations []Violation
+	Err        error
+}
+
+func NewError(kind error, reason, message string) *Error {
+	return &Error{
+		Kind:    kind,
+		Reason:  reason,
+		Message: message,
+	}
+}
+
+func NewNotFoundError(reason, message string) *Error {
+	return NewError(ErrNotFound, reason, message)
+}
+
+func NewAlreadyExistsError(reason, message string) *Error {
+	return NewError(ErrAlreadyExists, reason, message)
+}
+
+func NewInvalidArgumentError(reason, message string) *Error {
+	return NewError(ErrInvalidArgument, reason, message)
+}
+
+func NewFailedPreconditionError(reason, message string) *Error {
+	return NewError(ErrFailedPrecondition, reason, message)
+}
+
+func NewConflictError(reason, message string) *Error {
+	return NewError(ErrConflict, reason, message)
+}
+
+func NewUnauthenticatedError(reason, message string) *Error {
+	return NewError(ErrUnauthenticated, reason, message)
+}
+
+func NewPermissionDeniedError(reason, message string) *Error {
+	return NewError(ErrPermissionDenied, reason, message)
+}
+
+func NewResourceExhaustedError(reason, message string) *Error {
+	return NewError(ErrResourceExhausted, reason, message)
+}
+
+// NewStatusTransitionError is returned when an order is asked to move to a
+// status that is not reachable from its current one.
+func NewStatusTransitionError(from, to OrderStatus) *Error {
+	return NewFailedPreconditionError(
+		"INVALID_STATUS_TRANSITION",
+		fmt.Sprintf("order status transition %s -> %s is not allowed", from, to),
+	).
+		WithMetadata("from", from.String()).
+		WithMetadata("to", to.String())
+}
+
+func (e *Error) WithMetadata(key, value string) *Error {
+	if e.Metadata == nil {
+		e.Metadata = make(map[string]string)
+	}
+	e.Metadata[key] = value
+	return e
+}
+
+func (e *Error) WithViolation(subject, description string) *Error {
+	e.Violations = append(e.Violations, Violation{Subject: subject, Description: description})
+	return e
+}
+
+func (e *Error) WithCause(err error) *Error {
+	e.Err = err
+	return e
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+func (e *Error) Is(target error) bool {
+	return target == e.Kind
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
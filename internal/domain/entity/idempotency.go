@@ -0,0 +1,18 @@
+package entity
+
+import "time"
+
+// IdempotencyKey records the outcome of a request sent with an idempotency
+// key. Keys are scoped to UserID, the caller that sent them, or the empty
+// string for unauthenticated calls. Response is nil while the original request
+// is still being processed. Completed is set once the request has committed
+// its changes, which may happen before its response is stored.
+type IdempotencyKey struct {
+	UserID      string
+	Key         string
+	Method      string
+	RequestHash []byte
+	Response    []byte
+	Completed   bool
+	ExpiresAt   time.Time
+}
@@ -0,0 +1,14 @@
+package entity
+
+// KitchenTicket is an order as shown on the kitchen screen, with the names
+// of its menu items resolved.
+type KitchenTicket struct {
+	Order Order
+	Items []KitchenTicketItem
+}
+
+type KitchenTicketItem struct {
+	MenuItemID string
+	Name       string
+	Quantity   int32
+}
@@ -11,7 +11,7 @@ type MenuItem struct {
 	CategoryID  string
 	Name        string
 	Description string
-	Price       float64
+	Price       Money
 	Active      bool
 	ImageURL    string
 }
@@ -0,0 +1,38 @@
+package entity
+
+import "fmt"
+
+const DefaultCurrency = "RUB"
+
+// Money is an amount in the minor units of a currency, e.g. kopecks for RUB.
+type Money struct {
+	Amount   int64
+	Currency string // ISO 4217 code
+}
+
+func NewMoney(amount int64, currency string) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// Add returns the sum of m and other. The zero Money is treated as zero in
+// any currency, so totals can be accumulated starting from it.
+func (m Money) Add(other Money) (Money, error) {
+	if m.Currency == "" {
+		return other, nil
+	}
+	if other.Currency == "" {
+		return m, nil
+	}
+	if m.Currency != other.Currency {
+		return Money{}, NewFailedPreconditionError(
+			"CURRENCY_MISMATCH",
+			fmt.Sprintf("cannot add %s to %s", other.Currency, m.Currency),
+		)
+	}
+	return Money{Amount: m.Amount + other.Amount, Currency: m.Currency}, nil
+}
+
+// Multiply returns m times n.
+func (m Money) Multiply(n int64) Money {
+	return Money{Amount: m.Amount * n, Currency: m.Currency}
+}
@@ -1,5 +1,7 @@
 package entity
 
+import "time"
+
 type OrderStatus int32
 
 const (
@@ -14,10 +16,54 @@ const (
 	OrderStatusFailed
 )
 
+var orderStatusNames = map[OrderStatus]string{
+	OrderStatusUnspecified:     "UNSPECIFIED",
+	OrderStatusDraft:           "DRAFT",
+	OrderStatusAwaitingPayment: "AWAITING_PAYMENT",
+	OrderStatusPaid:            "PAID",
+	OrderStatusInProgress:      "IN_PROGRESS",
+	OrderStatusReady:           "READY",
+	OrderStatusCompleted:       "COMPLETED",
+	OrderStatusCancelled:       "CANCELLED",
+	OrderStatusFailed:          "FAILED",
+}
+
+// orderStatusTransitions lists, for every status, the statuses an order may
+// move to next. Statuses missing from the map are terminal.
+var orderStatusTransitions = map[OrderStatus][]OrderStatus{
+	OrderStatusDraft:           {OrderStatusAwaitingPayment, OrderStatusCancelled},
+	OrderStatusAwaitingPayment: {OrderStatusPaid, OrderStatusCancelled, OrderStatusFailed},
+	OrderStatusPaid:            {OrderStatusInProgress, OrderStatusCancelled, OrderStatusFailed},
+	OrderStatusInProgress:      {OrderStatusReady, OrderStatusCancelled, OrderStatusFailed},
+	OrderStatusReady:           {OrderStatusCompleted, OrderStatusCancelled},
+}
+
+func (s OrderStatus) String() string {
+	if name, ok := orderStatusNames[s]; ok {
+		return name
+	}
+	return "UNKNOWN"
+}
+
+// CanTransitionTo reports whether an order in status s may be moved to next.
+func (s OrderStatus) CanTransitionTo(next OrderStatus) bool {
+	for _, allowed := range orderStatusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// IsTerminal reports whether no further transitions are allowed from s.
+func (s OrderStatus) IsTerminal() bool {
+	return len(orderStatusTransitions[s]) == 0
+}
+
 type OrderItem struct {
 	MenuItemID string
 	Quantity   int32
-	UnitPrice  int64
+	UnitPrice  Money
 }
 
 type Order struct {
@@ -25,9 +71,37 @@ type Order struct {
 	UserID       string
 	RestaurantID string
 	Status       OrderStatus
-	TotalAmount  int64
+	TotalAmount  Money
 	Items        []OrderItem
 	PickUp       bool
+	PickupTime   time.Time // zero when no pickup slot was requested
 	CreatedAt    int64
 	UpdatedAt    int64
 }
+
+// OrderStatusChange is a single entry of an order's status history.
+type OrderStatusChange struct {
+	OrderID   string
+	From      OrderStatus
+	To        OrderStatus
+	Reason    string
+	ChangedBy string
+	ChangedAt int64
+}
+
+// OrderView selects how much of an order list endpoints return.
+type OrderView int32
+
+const (
+	// OrderViewBasic returns orders without their items.
+	OrderViewBasic OrderView = iota
+	// OrderViewFull returns orders with their items.
+	OrderViewFull
+)
+
+// OrderCursor is a position in a list of orders sorted by creation time and
+// ID, newest first. Listing after a cursor returns the orders that follow it.
+type OrderCursor struct {
+	CreatedAt time.Time
+	ID        string
+}
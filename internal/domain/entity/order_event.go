@@ -0,0 +1,24 @@
+package entity
+
+import "time"
+
+const (
+	OrderEventCreated       = "order.created"
+	OrderEventStatusChanged = "order.status_changed"
+	OrderEventCancelled     = "order.cancelled"
+)
+
+// OrderEvent is the payload of order events published to Kafka.
+type OrderEvent struct {
+	Type         string    `json:"type"`
+	OrderID      string    `json:"order_id"`
+	UserID       string    `json:"user_id"`
+	RestaurantID string    `json:"restaurant_id"`
+	FromStatus   string    `json:"from_status,omitempty"`
+	Status       string    `json:"status"`
+	Reason       string    `json:"reason,omitempty"`
+	ChangedBy    string    `json:"changed_by,omitempty"`
+	TotalAmount  int64     `json:"total_amount"`
+	Currency     string    `json:"currency"`
+	OccurredAt   time.Time `json:"occurred_at"`
+}
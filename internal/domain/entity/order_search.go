@@ -0,0 +1,31 @@
+package entity
+
+import "time"
+
+// OrderFilter narrows down an order search. Zero fields do not filter.
+type OrderFilter struct {
+	CustomerPhone string
+	PlaceID       string
+	Statuses      []OrderStatus
+	// CreatedFrom and CreatedTo bound the creation time to [CreatedFrom, CreatedTo).
+	CreatedFrom    time.Time
+	CreatedTo      time.Time
+	MinTotalAmount *int64
+	MaxTotalAmount *int64
+	PickUp         *bool
+}
+
+type OrderSortField int32
+
+const (
+	OrderSortByCreatedAt OrderSortField = iota
+	OrderSortByTotalAmount
+	OrderSortByPickupTime
+)
+
+// OrderSort orders search results. Orders with equal values are sorted by
+// ID in the same direction, so pages are stable.
+type OrderSort struct {
+	Field     OrderSortField
+	Ascending bool
+}
@@ -0,0 +1,57 @@
+package entity
+
+import "testing"
+
+func TestOrderStatusCanTransitionTo(t *testing.T) {
+	type transition struct{ from, to OrderStatus }
+	allowed := map[transition]bool{
+		{OrderStatusDraft, OrderStatusAwaitingPayment}:     true,
+		{OrderStatusDraft, OrderStatusCancelled}:           true,
+		{OrderStatusAwaitingPayment, OrderStatusPaid}:      true,
+		{OrderStatusAwaitingPayment, OrderStatusCancelled}: true,
+		{OrderStatusAwaitingPayment, OrderStatusFailed}:    true,
+		{OrderStatusPaid, OrderStatusInProgress}:           true,
+		{OrderStatusPaid, OrderStatusCancelled}:            true,
+		{OrderStatusPaid, OrderStatusFailed}:               true,
+		{OrderStatusInProgress, OrderStatusReady}:          true,
+		{OrderStatusInProgress, OrderStatusCancelled}:      true,
+		{OrderStatusInProgress, OrderStatusFailed}:         true,
+		{OrderStatusReady, OrderStatusCompleted}:           true,
+		{OrderStatusReady, OrderStatusCancelled}:           true,
+	}
+
+	// Every pair of known statuses, plus one unknown value on each side.
+	for from := OrderStatusUnspecified; from <= OrderStatusFailed+1; from++ {
+		for to := OrderStatusUnspecified; to <= OrderStatusFailed+1; to++ {
+			t.Run(from.String()+"->"+to.String(), func(t *testing.T) {
+				want := allowed[transition{from, to}]
+				if got := from.CanTransitionTo(to); got != want {
+					t.Errorf("%v.CanTransitionTo(%v) = %v, want %v", from, to, got, want)
+				}
+			})
+		}
+	}
+}
+
+func TestOrderStatusIsTerminal(t *testing.T) {
+	tests := []struct {
+		status OrderStatus
+		want   bool
+	}{
+		{OrderStatusUnspecified, true},
+		{OrderStatusDraft, false},
+		{OrderStatusAwaitingPayment, false},
+		{OrderStatusPaid, false},
+		{OrderStatusInProgress, false},
+		{OrderStatusReady, false},
+		{OrderStatusCompleted, true},
+		{OrderStatusCancelled, true},
+		{OrderStatusFailed, true},
+	}
+
+	for _, tt := range tests {
+		if got := tt.status.IsTerminal(); got != tt.want {
+			t.Errorf("%v.IsTerminal() = %v, want %v", tt.status, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,15 @@
+package entity
+
+import "time"
+
+// OutboxMessage is an event stored in the same transaction as the change
+// it describes and published to Kafka afterwards by the outbox relay.
+type OutboxMessage struct {
+	ID        int64
+	Topic     string
+	Key       string
+	EventType string
+	Payload   []byte
+	Attempts  int32
+	CreatedAt time.Time
+}
@@ -0,0 +1,134 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// OpeningHours are the local opening hours of a place on one day of the week.
+type OpeningHours struct {
+	DayOfWeek int32  // ISO 8601: 1 is Monday, 7 is Sunday
+	OpensAt   string // HH:MM
+	ClosesAt  string // HH:MM
+}
+
+type Place struct {
+	ID           string
+	Name         string
+	Address      string
+	Latitude     float64
+	Longitude    float64
+	OpeningHours []OpeningHours
+	Active       bool
+	// Timezone is the IANA name of the zone the opening hours are given in.
+	Timezone           string
+	PickupSlotMinutes  int32
+	PickupSlotCapacity int32
+}
+
+// PickupSlot is a pickup window of a place. Remaining is the number of
+// orders that can still be scheduled into it.
+type PickupSlot struct {
+	Start     time.Time
+	End       time.Time
+	Capacity  int32
+	Remaining int32
+}
+
+// Location returns the time zone of the place.
+func (p *Place) Location() (*time.Location, error) {
+	loc, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("load place time zone %q: %w", p.Timezone, err)
+	}
+	return loc, nil
+}
+
+// PickupSlots returns every pickup slot of the place on the local date of
+// day, in chronological order. Remaining is set to the full capacity.
+func (p *Place) PickupSlots(day time.Time) ([]PickupSlot, error) {
+	loc, err := p.Location()
+	if err != nil {
+		return nil, err
+	}
+	if p.PickupSlotMinutes <= 0 {
+		return nil, nil
+	}
+
+	day = day.In(loc)
+	weekday := int32(day.Weekday())
+	if weekday == 0 {
+		weekday = 7
+	}
+
+	var slots []PickupSlot
+	for _, h := range p.OpeningHours {
+		if h.DayOfWeek != weekday {
+			continue
+		}
+
+		opensAt, err := timeOfDay(day, h.OpensAt, loc)
+		if err != nil {
+			return nil, err
+		}
+		closesAt, err := timeOfDay(day, h.ClosesAt, loc)
+		if err != nil {
+			return nil, err
+		}
+
+		step := time.Duration(p.PickupSlotMinutes) * time.Minute
+		for start := opensAt; !start.Add(step).After(closesAt); start = start.Add(step) {
+			slots = append(slots, PickupSlot{
+				Start:     start,
+				End:       start.Add(step),
+				Capacity:  p.PickupSlotCapacity,
+				Remaining: p.PickupSlotCapacity,
+			})
+		}
+	}
+
+	return slots, nil
+}
+
+// PickupSlotAt returns the slot that starts exactly at t.
+func (p *Place) PickupSlotAt(t time.Time) (PickupSlot, bool, error) {
+	slots, err := p.PickupSlots(t)
+	if err != nil {
+		return PickupSlot{}, false, err
+	}
+	for _, slot := range slots {
+		if slot.Start.Equal(t) {
+			return slot, true, nil
+		}
+	}
+	return PickupSlot{}, false, nil
+}
+
+// Contains reports whether t falls within the slot.
+func (s PickupSlot) Contains(t time.Time) bool {
+	return !t.Before(s.Start) && t.Before(s.End)
+}
+
+// ApplyPickupCounts takes the orders counted by pickup time, keyed by Unix
+// time, off the Remaining capacity of the slots they fall in. Pickup times
+// need not be slot starts: orders keep theirs when the place changes its
+// slot length or opening hours.
+func ApplyPickupCounts(slots []PickupSlot, counts map[int64]int32) {
+	for pickupTime, count := range counts {
+		t := time.Unix(pickupTime, 0)
+		for i := range slots {
+			if slots[i].Contains(t) {
+				slots[i].Remaining = max(slots[i].Remaining-count, 0)
+				break
+			}
+		}
+	}
+}
+
+func timeOfDay(day time.Time, hhmm string, loc *time.Location) (time.Time, error) {
+	t, err := time.Parse("15:04", hhmm)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse time of day %q: %w", hhmm, err)
+	}
+	return time.Date(day.Year(), day.Month(), day.Day(), t.Hour(), t.Minute(), 0, 0, loc), nil
+}
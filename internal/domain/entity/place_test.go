@@ -0,0 +1,114 @@
+package entity
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestPickupSlotsAfterReconfiguration(t *testing.T) {
+	// A Monday, with orders booked while the place had 30 minute slots from
+	// 09:00.
+	day := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	at := func(hour, minute int) time.Time {
+		return time.Date(2026, 10, 19, hour, minute, 0, 0, time.UTC)
+	}
+	counts := map[int64]int32{
+		at(9, 0).Unix():   2,
+		at(9, 30).Unix():  1,
+		at(10, 30).Unix(): 1,
+	}
+
+	tests := []struct {
+		name          string
+		slotMinutes   int32
+		opensAt       string
+		closesAt      string
+		wantStarts    []time.Time
+		wantRemaining []int32
+		lookup        time.Time
+		wantFound     bool
+	}{
+		{
+			name:          "original slots",
+			slotMinutes:   30,
+			opensAt:       "09:00",
+			closesAt:      "11:00",
+			wantStarts:    []time.Time{at(9, 0), at(9, 30), at(10, 0), at(10, 30)},
+			wantRemaining: []int32{1, 2, 3, 2},
+			lookup:        at(9, 30),
+			wantFound:     true,
+		},
+		{
+			name:          "longer slots",
+			slotMinutes:   60,
+			opensAt:       "09:00",
+			closesAt:      "11:00",
+			wantStarts:    []time.Time{at(9, 0), at(10, 0)},
+			wantRemaining: []int32{0, 2},
+			lookup:        at(9, 30),
+			wantFound:     false,
+		},
+		{
+			name:          "later opening",
+			slotMinutes:   45,
+			opensAt:       "09:15",
+			closesAt:      "11:30",
+			wantStarts:    []time.Time{at(9, 15), at(10, 0), at(10, 45)},
+			wantRemaining: []int32{2, 2, 3},
+			lookup:        at(10, 45),
+			wantFound:     true,
+		},
+		{
+			name:          "slot shorter than opening hours",
+			slotMinutes:   90,
+			opensAt:       "09:00",
+			closesAt:      "10:00",
+			wantStarts:    nil,
+			wantRemaining: nil,
+			lookup:        at(9, 0),
+			wantFound:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			place := &Place{
+				Timezone:           "UTC",
+				PickupSlotMinutes:  tt.slotMinutes,
+				PickupSlotCapacity: 3,
+				OpeningHours:       []OpeningHours{{DayOfWeek: 1, OpensAt: tt.opensAt, ClosesAt: tt.closesAt}},
+			}
+
+			slots, err := place.PickupSlots(day)
+			if err != nil {
+				t.Fatalf("PickupSlots() error = %v", err)
+			}
+			ApplyPickupCounts(slots, counts)
+
+			var starts []time.Time
+			var remaining []int32
+			for _, slot := range slots {
+				starts = append(starts, slot.Start)
+				remaining = append(remaining, slot.Remaining)
+			}
+			if !slices.EqualFunc(starts, tt.wantStarts, time.Time.Equal) {
+				t.Errorf("slot starts = %v, want %v", starts, tt.wantStarts)
+			}
+			if !slices.Equal(remaining, tt.wantRemaining) {
+				t.Errorf("remaining = %v, want %v", remaining, tt.wantRemaining)
+			}
+
+			slot, found, err := place.PickupSlotAt(tt.lookup)
+			if err != nil {
+				t.Fatalf("PickupSlotAt() error = %v", err)
+			}
+			if found != tt.wantFound {
+				t.Fatalf("PickupSlotAt(%v) found = %v, want %v", tt.lookup, found, tt.wantFound)
+			}
+			if found && (!slot.Start.Equal(tt.lookup) || slot.End.Sub(slot.Start) != time.Duration(tt.slotMinutes)*time.Minute) {
+				t.Errorf("PickupSlotAt(%v) = %v-%v", tt.lookup, slot.Start, slot.End)
+			}
+		})
+	}
+}
@@ -1,7 +1,10 @@
 package entity
 
 type User struct {
-	ID    string
-	Phone string
-	Name  string
+	ID               string
+	Phone            string
+	Name             string
+	Email            string
+	MarketingConsent bool
+	Role             Role
 }
@@ -0,0 +1,69 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	healthUC "github.com/Tortik3000/service-order/internal/usecase/health"
+	"github.com/Tortik3000/service-order/pkg/logger"
+)
+
+// readinessTimeout bounds the checks of a readiness probe.
+const readinessTimeout = 3 * time.Second
+
+type Handler interface {
+	Liveness(w http.ResponseWriter, r *http.Request)
+	Readiness(w http.ResponseWriter, r *http.Request)
+}
+
+type healthUseCase interface {
+	Ready(ctx context.Context) error
+}
+
+type handler struct {
+	uc   healthUseCase
+	logs logger.Logger
+}
+
+var _ Handler = (*handler)(nil)
+
+func NewHealthHandler(uc healthUseCase, logs logger.Logger) *handler {
+	return &handler{
+		uc:   uc,
+		logs: logs,
+	}
+}
+
+// Liveness answers as long as the process serves HTTP.
+func (h *handler) Liveness(w http.ResponseWriter, _ *http.Request) {
+	writeStatus(w, http.StatusOK, "ok")
+}
+
+// Readiness answers 503 if the service should not get traffic. Only the name
+// of the failed check is sent, the error itself is logged.
+func (h *handler) Readiness(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), readinessTimeout)
+	defer cancel()
+
+	if err := h.uc.Ready(ctx); err != nil {
+		check := "unknown"
+		var checkErr *healthUC.CheckError
+		if errors.As(err, &checkErr) {
+			check = checkErr.Check
+		}
+		h.logs.Warn("readiness check failed", logger.Error(err))
+		writeStatus(w, http.StatusServiceUnavailable, "not ready: "+check)
+		return
+	}
+
+	writeStatus(w, http.StatusOK, "ready")
+}
+
+func writeStatus(w http.ResponseWriter, code int, body string) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	_, _ = w.Write([]byte(body + "\n"))
+}
@@ -0,0 +1,117 @@
+package interceptors
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/Tortik3000/service-order/internal/domain/entity"
+	"github.com/Tortik3000/service-order/pkg/token"
+)
+
+// AuthorizationHeader is the gRPC metadata key of the bearer token. The
+// gateway forwards the Authorization HTTP header under it.
+const AuthorizationHeader = "authorization"
+
+// Public marks methods that can be called without a token.
+const Public = entity.RoleUnspecified
+
+type tokenParser interface {
+	Parse(kind token.Kind, signed string) (*token.Claims, error)
+}
+
+type AuthInterceptor struct {
+	tokens tokenParser
+	rules  map[string]entity.Role
+}
+
+// NewAuthInterceptor requires the caller of every method to have at least
+// the role given for it in rules, where staff outrank customers and admins
+// outrank staff. Methods, named as in grpc.UnaryServerInfo.FullMethod, that
+// are missing from rules are open to any authenticated user.
+func NewAuthInterceptor(tokens tokenParser, rules map[string]entity.Role) *AuthInterceptor {
+	return &AuthInterceptor{
+		tokens: tokens,
+		rules:  rules,
+	}
+}
+
+func (i *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := i.authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (i *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := i.authorize(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authorize returns ctx with the principal of the request.
+func (i *AuthInterceptor) authorize(ctx context.Context, method string) (context.Context, error) {
+	required, ok := i.rules[method]
+	if !ok {
+		required = entity.RoleCustomer
+	}
+	if required == Public {
+		return ctx, nil
+	}
+
+	signed := bearerToken(ctx)
+	if signed == "" {
+		return nil, entity.NewUnauthenticatedError("MISSING_TOKEN", "authorization token is required")
+	}
+	claims, err := i.tokens.Parse(token.KindAccess, signed)
+	if err != nil {
+		return nil, entity.NewUnauthenticatedError("INVALID_TOKEN", "invalid or expired token").WithCause(err)
+	}
+
+	principal := entity.Principal{
+		UserID: claims.Subject,
+		Role:   entity.Role(claims.Role),
+	}
+	if principal.Role < required {
+		return nil, entity.NewPermissionDeniedError("ROLE_REQUIRED", "method is not allowed for the role").
+			WithMetadata("required_role", required.String())
+	}
+
+	return entity.ContextWithPrincipal(ctx, principal), nil
+}
+
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(AuthorizationHeader)
+	if len(values) == 0 {
+		return ""
+	}
+
+	scheme, signed, ok := strings.Cut(strings.TrimSpace(values[0]), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(signed)
+}
+
+// serverStream replaces the context of a stream.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
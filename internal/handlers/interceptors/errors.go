@@ -0,0 +1,159 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+
+	"github.com/Tortik3000/service-order/internal/domain/entity"
+	"github.com/Tortik3000/service-order/pkg/logger"
+)
+
+const errorDomain = "service-order"
+
+// validationError is implemented by the errors generated by protoc-gen-validate.
+type validationError interface {
+	error
+	Field() string
+	Reason() string
+}
+
+type ErrorInterceptor struct {
+	logs logger.Logger
+}
+
+func NewErrorInterceptor(logs logger.Logger) *ErrorInterceptor {
+	return &ErrorInterceptor{logs: logs}
+}
+
+func (i *ErrorInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, i.toStatus(info.FullMethod, err)
+		}
+		return resp, nil
+	}
+}
+
+func (i *ErrorInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, ss); err != nil {
+			return i.toStatus(info.FullMethod, err)
+		}
+		return nil
+	}
+}
+
+// toStatus converts err into a gRPC status error. Errors that already carry a
+// status are passed through; unrecognised errors are logged and reported as
+// Internal without exposing their text to the client.
+func (i *ErrorInterceptor) toStatus(method string, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var domainErr *entity.Error
+	if errors.As(err, &domainErr) {
+		return domainStatus(domainErr)
+	}
+
+	var validationErr validationError
+	if errors.As(err, &validationErr) {
+		st, detailsErr := status.New(codes.InvalidArgument, validationErr.Error()).WithDetails(&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+				Field:       validationErr.Field(),
+				Description: validationErr.Reason(),
+			}},
+		})
+		if detailsErr != nil {
+			return status.Error(codes.InvalidArgument, validationErr.Error())
+		}
+		return st.Err()
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+
+	if code := kindCode(err); code != codes.Unknown {
+		return status.Error(code, err.Error())
+	}
+
+	i.logs.Error("unhandled error",
+		logger.NewField("method", method),
+		logger.Error(err),
+	)
+	return status.Error(codes.Internal, "internal error")
+}
+
+func domainStatus(err *entity.Error) error {
+	code := kindCode(err)
+	st := status.New(code, err.Message)
+
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{
+		Reason:   err.Reason,
+		Domain:   errorDomain,
+		Metadata: err.Metadata,
+	}}
+
+	if len(err.Violations) > 0 {
+		switch code {
+		case codes.InvalidArgument:
+			violations := make([]*errdetails.BadRequest_FieldViolation, len(err.Violations))
+			for i, v := range err.Violations {
+				violations[i] = &errdetails.BadRequest_FieldViolation{
+					Field:       v.Subject,
+					Description: v.Description,
+				}
+			}
+			details = append(details, &errdetails.BadRequest{FieldViolations: violations})
+		default:
+			violations := make([]*errdetails.PreconditionFailure_Violation, len(err.Violations))
+			for i, v := range err.Violations {
+				violations[i] = &errdetails.PreconditionFailure_Violation{
+					Type:        err.Reason,
+					Subject:     v.Subject,
+					Description: v.Description,
+				}
+			}
+			details = append(details, &errdetails.PreconditionFailure{Violations: violations})
+		}
+	}
+
+	withDetails, detailsErr := st.WithDetails(details...)
+	if detailsErr != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+func kindCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, entity.ErrNotFound):
+		return codes.NotFound
+	case errors.Is(err, entity.ErrAlreadyExists):
+		return codes.AlreadyExists
+	case errors.Is(err, entity.ErrInvalidArgument):
+		return codes.InvalidArgument
+	case errors.Is(err, entity.ErrFailedPrecondition):
+		return codes.FailedPrecondition
+	case errors.Is(err, entity.ErrConflict):
+		return codes.Aborted
+	case errors.Is(err, entity.ErrUnauthenticated):
+		return codes.Unauthenticated
+	case errors.Is(err, entity.ErrPermissionDenied):
+		return codes.PermissionDenied
+	case errors.Is(err, entity.ErrResourceExhausted):
+		return codes.ResourceExhausted
+	}
+	return codes.Unknown
+}
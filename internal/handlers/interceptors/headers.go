@@ -0,0 +1,26 @@
+package interceptors
+
+import (
+	"net/textproto"
+
+	grpcruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+
+	"github.com/Tortik3000/service-order/pkg/metrics"
+)
+
+// forwardedHeaders are the HTTP headers the gateway passes to gRPC metadata
+// on top of the default ones, keyed by their canonical form.
+var forwardedHeaders = map[string]string{
+	textproto.CanonicalMIMEHeaderKey(IdempotencyKeyHeader):    IdempotencyKeyHeader,
+	textproto.CanonicalMIMEHeaderKey(metrics.RequestIDHeader): metrics.RequestIDHeader,
+}
+
+// IncomingHeaderMatcher forwards the Idempotency-Key and X-Request-Id HTTP
+// headers from the gateway to gRPC metadata in addition to the default
+// headers.
+func IncomingHeaderMatcher(key string) (string, bool) {
+	if header, ok := forwardedHeaders[textproto.CanonicalMIMEHeaderKey(key)]; ok {
+		return header, true
+	}
+	return grpcruntime.DefaultHeaderMatcher(key)
+}
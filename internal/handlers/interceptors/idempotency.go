@@ -0,0 +1,143 @@
+package interceptors
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/Tortik3000/service-order/internal/domain/entity"
+)
+
+// IdempotencyKeyHeader is the gRPC metadata key and, in canonical form, the
+// HTTP header that carries an idempotency key.
+const IdempotencyKeyHeader = "idempotency-key"
+
+const maxIdempotencyKeyLength = 255
+
+type idempotencyUseCase interface {
+	Do(ctx context.Context, userID, key, method string, requestHash []byte, fn func(ctx context.Context) ([]byte, error)) ([]byte, error)
+}
+
+type IdempotencyInterceptor struct {
+	uc      idempotencyUseCase
+	methods map[string]bool
+}
+
+// NewIdempotencyInterceptor makes the given methods, named as in
+// grpc.UnaryServerInfo.FullMethod, honour the idempotency key header. Keys
+// are scoped to the authenticated caller, so the interceptor must come after
+// the auth interceptor in the chain: a replay skips the handler and with it
+// every ownership check.
+func NewIdempotencyInterceptor(uc idempotencyUseCase, methods ...string) *IdempotencyInterceptor {
+	i := &IdempotencyInterceptor{
+		uc:      uc,
+		methods: make(map[string]bool, len(methods)),
+	}
+	for _, m := range methods {
+		i.methods[m] = true
+	}
+	return i
+}
+
+func (i *IdempotencyInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !i.methods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		key := idempotencyKey(ctx)
+		if key == "" {
+			return handler(ctx, req)
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			return nil, entity.NewInvalidArgumentError("INVALID_IDEMPOTENCY_KEY", "idempotency key is too long").
+				WithViolation(IdempotencyKeyHeader, fmt.Sprintf("must be at most %d characters", maxIdempotencyKeyLength))
+		}
+
+		reqMsg, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+		reqBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(reqMsg)
+		if err != nil {
+			return nil, fmt.Errorf("marshal request: %w", err)
+		}
+		hash := sha256.Sum256(reqBytes)
+
+		// Unauthenticated callers of public methods share the empty user.
+		principal, _ := entity.PrincipalFromContext(ctx)
+
+		var resp any
+		data, err := i.uc.Do(ctx, principal.UserID, key, info.FullMethod, hash[:], func(ctx context.Context) ([]byte, error) {
+			var err error
+			resp, err = handler(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			return proto.Marshal(resp.(proto.Message))
+		})
+		if err != nil {
+			return nil, err
+		}
+		if resp != nil {
+			return resp, nil
+		}
+
+		// The handler did not run: replay the stored response.
+		replayed, err := newResponse(info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		if err := proto.Unmarshal(data, replayed); err != nil {
+			return nil, fmt.Errorf("unmarshal stored response: %w", err)
+		}
+		return replayed, nil
+	}
+}
+
+func idempotencyKey(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(IdempotencyKeyHeader)
+	if len(values) == 0 {
+		return ""
+	}
+	return strings.TrimSpace(values[0])
+}
+
+// newResponse returns an empty response message of a method given as
+// "/package.Service/Method".
+func newResponse(fullMethod string) (proto.Message, error) {
+	service, method, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid method name %q", fullMethod)
+	}
+
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(service))
+	if err != nil {
+		return nil, fmt.Errorf("find service %s: %w", service, err)
+	}
+	serviceDesc, ok := desc.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a service", service)
+	}
+	methodDesc := serviceDesc.Methods().ByName(protoreflect.Name(method))
+	if methodDesc == nil {
+		return nil, fmt.Errorf("find method %s: not found", fullMethod)
+	}
+
+	msgType, err := protoregistry.GlobalTypes.FindMessageByName(methodDesc.Output().FullName())
+	if err != nil {
+		return nil, fmt.Errorf("find response type of %s: %w", fullMethod, err)
+	}
+	return msgType.New().Interface(), nil
+}
@@ -0,0 +1,61 @@
+package interceptors
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Tortik3000/service-order/pkg/logger"
+	"github.com/Tortik3000/service-order/pkg/metrics"
+	grpcMetrics "github.com/Tortik3000/service-order/pkg/metrics/grpc"
+)
+
+// RecoveryInterceptor turns a panic in a handler into an Internal error for
+// that call instead of crashing the server.
+type RecoveryInterceptor struct {
+	logs logger.Logger
+}
+
+func NewRecoveryInterceptor(logs logger.Logger) *RecoveryInterceptor {
+	return &RecoveryInterceptor{logs: logs}
+}
+
+func (i *RecoveryInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = i.recovered(ctx, info.FullMethod, r)
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+func (i *RecoveryInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = i.recovered(ss.Context(), info.FullMethod, r)
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
+
+func (i *RecoveryInterceptor) recovered(ctx context.Context, fullMethod string, r any) error {
+	i.logs.Error("panic in grpc handler",
+		logger.NewField("method", fullMethod),
+		logger.NewField("request_id", metrics.RequestID(ctx)),
+		logger.NewField("panic", fmt.Sprint(r)),
+		logger.NewField("stack", string(debug.Stack())),
+	)
+
+	service, method := metrics.SplitMethod(fullMethod)
+	grpcMetrics.GRPCPanicTotal.WithLabelValues(service, method).Inc()
+
+	return status.Error(codes.Internal, "internal error")
+}
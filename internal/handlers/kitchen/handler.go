@@ -0,0 +1,127 @@
+package kitchen
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Tortik3000/service-order/generated/api/kitchen"
+	"github.com/Tortik3000/service-order/internal/domain/entity"
+)
+
+type Handler interface {
+	GetQueue(ctx context.Context, req *kitchen.GetQueueRequest) (*kitchen.GetQueueResponse, error)
+	StartPreparing(ctx context.Context, req *kitchen.StartPreparingRequest) (*kitchen.StartPreparingResponse, error)
+	MarkReady(ctx context.Context, req *kitchen.MarkReadyRequest) (*kitchen.MarkReadyResponse, error)
+	WatchQueue(req *kitchen.WatchQueueRequest, stream kitchen.KitchenService_WatchQueueServer) error
+}
+
+type (
+	kitchenUseCase interface {
+		GetQueue(ctx context.Context, placeID string) ([]entity.KitchenTicket, error)
+		StartPreparing(ctx context.Context, orderID, changedBy string) (*entity.KitchenTicket, error)
+		MarkReady(ctx context.Context, orderID, changedBy string) (*entity.KitchenTicket, error)
+		WatchQueue(ctx context.Context, placeID string, send func([]entity.KitchenTicket) error) error
+	}
+)
+
+type handler struct {
+	kitchen.UnimplementedKitchenServiceServer
+	uc kitchenUseCase
+}
+
+var _ Handler = (*handler)(nil)
+
+func NewKitchenHandler(u kitchenUseCase) *handler {
+	return &handler{uc: u}
+}
+
+func (h *handler) GetQueue(ctx context.Context, req *kitchen.GetQueueRequest) (*kitchen.GetQueueResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	tickets, err := h.uc.GetQueue(ctx, req.PlaceId)
+	if err != nil {
+		return nil, err
+	}
+	return &kitchen.GetQueueResponse{Tickets: mapTicketsToProto(tickets)}, nil
+}
+
+func (h *handler) StartPreparing(ctx context.Context, req *kitchen.StartPreparingRequest) (*kitchen.StartPreparingResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	principal, _ := entity.PrincipalFromContext(ctx)
+	t, err := h.uc.StartPreparing(ctx, req.OrderId, principal.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &kitchen.StartPreparingResponse{Ticket: mapTicketToProto(t)}, nil
+}
+
+func (h *handler) MarkReady(ctx context.Context, req *kitchen.MarkReadyRequest) (*kitchen.MarkReadyResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	principal, _ := entity.PrincipalFromContext(ctx)
+	t, err := h.uc.MarkReady(ctx, req.OrderId, principal.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &kitchen.MarkReadyResponse{Ticket: mapTicketToProto(t)}, nil
+}
+
+func (h *handler) WatchQueue(req *kitchen.WatchQueueRequest, stream kitchen.KitchenService_WatchQueueServer) error {
+	if err := req.Validate(); err != nil {
+		return err
+	}
+	return h.uc.WatchQueue(stream.Context(), req.PlaceId, func(tickets []entity.KitchenTicket) error {
+		return stream.Send(&kitchen.WatchQueueResponse{Tickets: mapTicketsToProto(tickets)})
+	})
+}
+
+func mapTicketsToProto(tickets []entity.KitchenTicket) []*kitchen.Ticket {
+	res := make([]*kitchen.Ticket, len(tickets))
+	for i := range tickets {
+		res[i] = mapTicketToProto(&tickets[i])
+	}
+	return res
+}
+
+func mapTicketToProto(t *entity.KitchenTicket) *kitchen.Ticket {
+	items := make([]*kitchen.TicketItem, len(t.Items))
+	for i, it := range t.Items {
+		items[i] = &kitchen.TicketItem{
+			MenuItemId: it.MenuItemID,
+			Name:       it.Name,
+			Quantity:   it.Quantity,
+		}
+	}
+
+	var pickupTime *timestamppb.Timestamp
+	if !t.Order.PickupTime.IsZero() {
+		pickupTime = timestamppb.New(t.Order.PickupTime)
+	}
+
+	return &kitchen.Ticket{
+		OrderId:    t.Order.ID,
+		Status:     mapTicketStatusToProto(t.Order.Status),
+		Items:      items,
+		PickUp:     t.Order.PickUp,
+		PickupTime: pickupTime,
+		CreatedAt:  t.Order.CreatedAt,
+	}
+}
+
+func mapTicketStatusToProto(s entity.OrderStatus) kitchen.TicketStatus {
+	switch s {
+	case entity.OrderStatusPaid:
+		return kitchen.TicketStatus_TICKET_STATUS_WAITING
+	case entity.OrderStatusInProgress:
+		return kitchen.TicketStatus_TICKET_STATUS_PREPARING
+	case entity.OrderStatusReady:
+		return kitchen.TicketStatus_TICKET_STATUS_READY
+	default:
+		return kitchen.TicketStatus_TICKET_STATUS_UNSPECIFIED
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/Tortik3000/service-order/generated/api/menu"
+	"github.com/Tortik3000/service-order/generated/api/money"
 	"github.com/Tortik3000/service-order/internal/domain/entity"
 )
 
@@ -68,7 +69,7 @@ func (h *handler) CreateMenuItem(ctx context.Context, req *menu.CreateMenuItemRe
 		CategoryID:  req.CategoryId,
 		Name:        req.Name,
 		Description: req.Description,
-		Price:       req.Price,
+		Price:       mapMoneyFromProto(req.Price),
 		Active:      true,
 		ImageURL:    req.ImageUrl,
 	}
@@ -87,7 +88,7 @@ func (h *handler) UpdateMenuItem(ctx context.Context, req *menu.UpdateMenuItemRe
 		CategoryID:  req.CategoryId,
 		Name:        req.Name,
 		Description: req.Description,
-		Price:       req.Price,
+		Price:       mapMoneyFromProto(req.Price),
 		Active:      true,
 		ImageURL:    req.ImageUrl,
 	}
@@ -125,8 +126,19 @@ func mapMenuItemToProto(m *entity.MenuItem) *menu.MenuItem {
 		CategoryId:  m.CategoryID,
 		Name:        m.Name,
 		Description: m.Description,
-		Price:       int64(m.Price),
+		Price:       mapMoneyToProto(m.Price),
 		Active:      m.Active,
 		ImageUrl:    m.ImageURL,
 	}
 }
+
+func mapMoneyFromProto(m *money.Money) entity.Money {
+	return entity.NewMoney(m.GetAmount(), m.GetCurrencyCode())
+}
+
+func mapMoneyToProto(m entity.Money) *money.Money {
+	return &money.Money{
+		Amount:       m.Amount,
+		CurrencyCode: m.Currency,
+	}
+}
@@ -2,7 +2,11 @@ package order
 
 import (
 	"context"
+	"time"
 
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Tortik3000/service-order/generated/api/money"
 	"github.com/Tortik3000/service-order/generated/api/order"
 	"github.com/Tortik3000/service-order/internal/domain/entity"
 )
@@ -12,18 +16,24 @@ type Handler interface {
 	GetOrder(ctx context.Context, req *order.GetOrderRequest) (*order.GetOrderResponse, error)
 	ListUserOrders(ctx context.Context, req *order.ListUserOrdersRequest) (*order.ListUserOrdersResponse, error)
 	ListOrdersByStatus(ctx context.Context, req *order.ListOrdersByStatusRequest) (*order.ListOrdersByStatusResponse, error)
+	SearchOrders(ctx context.Context, req *order.SearchOrdersRequest) (*order.SearchOrdersResponse, error)
 	UpdateOrderStatus(ctx context.Context, req *order.UpdateOrderStatusRequest) (*order.UpdateOrderStatusResponse, error)
 	CancelOrder(ctx context.Context, req *order.CancelOrderRequest) (*order.CancelOrderResponse, error)
+	GetOrderHistory(ctx context.Context, req *order.GetOrderHistoryRequest) (*order.GetOrderHistoryResponse, error)
+	WatchOrder(req *order.WatchOrderRequest, stream order.OrderService_WatchOrderServer) error
 }
 
 type (
 	orderUseCase interface {
-		CreateOrder(ctx context.Context, userID, restaurantID string, items []entity.OrderItem, pickUp bool) (*entity.Order, error)
+		CreateOrder(ctx context.Context, userID, restaurantID string, items []entity.OrderItem, pickUp bool, pickupTime time.Time) (*entity.Order, error)
 		GetOrder(ctx context.Context, id string) (*entity.Order, error)
-		ListUserOrders(ctx context.Context, userID string, limit, offset int32) ([]entity.Order, error)
-		ListOrdersByStatus(ctx context.Context, statuses []entity.OrderStatus, limit, offset int32) ([]entity.Order, error)
-		UpdateOrderStatus(ctx context.Context, id string, status entity.OrderStatus) (*entity.Order, error)
-		CancelOrder(ctx context.Context, id string, reason string) (*entity.Order, error)
+		ListUserOrders(ctx context.Context, userID string, pageSize int32, pageToken string, view entity.OrderView) ([]entity.Order, string, error)
+		ListOrdersByStatus(ctx context.Context, statuses []entity.OrderStatus, pageSize int32, pageToken string, view entity.OrderView) ([]entity.Order, string, error)
+		SearchOrders(ctx context.Context, filter entity.OrderFilter, sort entity.OrderSort, limit, offset int32, view entity.OrderView) ([]entity.Order, int64, error)
+		UpdateOrderStatus(ctx context.Context, id string, status entity.OrderStatus, reason, changedBy string) (*entity.Order, error)
+		CancelOrder(ctx context.Context, id string, reason, changedBy string) (*entity.Order, error)
+		GetOrderHistory(ctx context.Context, id string) ([]entity.OrderStatusChange, error)
+		WatchOrder(ctx context.Context, id string, send func(*entity.Order) error) error
 	}
 )
 
@@ -47,11 +57,16 @@ func (h *handler) CreateOrder(ctx context.Context, req *order.CreateOrderRequest
 		items[i] = entity.OrderItem{
 			MenuItemID: it.MenuItemId,
 			Quantity:   it.Quantity,
-			UnitPrice:  it.UnitPrice,
+			UnitPrice:  mapMoneyFromProto(it.UnitPrice),
 		}
 	}
 
-	o, err := h.uc.CreateOrder(ctx, req.UserId, req.RestaurantId, items, req.PickUp)
+	var pickupTime time.Time
+	if req.PickupTime != nil {
+		pickupTime = req.PickupTime.AsTime()
+	}
+
+	o, err := h.uc.CreateOrder(ctx, req.UserId, req.RestaurantId, items, req.PickUp, pickupTime)
 	if err != nil {
 		return nil, err
 	}
@@ -70,7 +85,10 @@ func (h *handler) GetOrder(ctx context.Context, req *order.GetOrderRequest) (*or
 }
 
 func (h *handler) ListUserOrders(ctx context.Context, req *order.ListUserOrdersRequest) (*order.ListUserOrdersResponse, error) {
-	orders, err := h.uc.ListUserOrders(ctx, req.UserId, req.Limit, req.Offset)
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	orders, nextPageToken, err := h.uc.ListUserOrders(ctx, req.UserId, req.Limit, req.PageToken, mapViewFromProto(req.View))
 	if err != nil {
 		return nil, err
 	}
@@ -79,16 +97,42 @@ func (h *handler) ListUserOrders(ctx context.Context, req *order.ListUserOrdersR
 	for i, o := range orders {
 		res[i] = mapOrderToProto(&o)
 	}
-	return &order.ListUserOrdersResponse{Orders: res}, nil
+	return &order.ListUserOrdersResponse{Orders: res, NextPageToken: nextPageToken}, nil
 }
 
 func (h *handler) ListOrdersByStatus(ctx context.Context, req *order.ListOrdersByStatusRequest) (*order.ListOrdersByStatusResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
 	statuses := make([]entity.OrderStatus, len(req.Statuses))
 	for i, s := range req.Statuses {
 		statuses[i] = entity.OrderStatus(s)
 	}
 
-	orders, err := h.uc.ListOrdersByStatus(ctx, statuses, req.Limit, req.Offset)
+	orders, nextPageToken, err := h.uc.ListOrdersByStatus(ctx, statuses, req.Limit, req.PageToken, mapViewFromProto(req.View))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*order.Order, len(orders))
+	for i, o := range orders {
+		res[i] = mapOrderToProto(&o)
+	}
+	return &order.ListOrdersByStatusResponse{Orders: res, NextPageToken: nextPageToken}, nil
+}
+
+func (h *handler) SearchOrders(ctx context.Context, req *order.SearchOrdersRequest) (*order.SearchOrdersResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	orders, total, err := h.uc.SearchOrders(ctx,
+		mapFilterFromProto(req.Filter),
+		mapSortFromProto(req.Sort),
+		req.Limit,
+		req.Offset,
+		mapViewFromProto(req.View),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -97,14 +141,15 @@ func (h *handler) ListOrdersByStatus(ctx context.Context, req *order.ListOrdersB
 	for i, o := range orders {
 		res[i] = mapOrderToProto(&o)
 	}
-	return &order.ListOrdersByStatusResponse{Orders: res}, nil
+	return &order.SearchOrdersResponse{Orders: res, TotalCount: total}, nil
 }
 
 func (h *handler) UpdateOrderStatus(ctx context.Context, req *order.UpdateOrderStatusRequest) (*order.UpdateOrderStatusResponse, error) {
 	if err := req.Validate(); err != nil {
 		return nil, err
 	}
-	o, err := h.uc.UpdateOrderStatus(ctx, req.OrderId, entity.OrderStatus(req.NewStatus))
+	principal, _ := entity.PrincipalFromContext(ctx)
+	o, err := h.uc.UpdateOrderStatus(ctx, req.OrderId, entity.OrderStatus(req.NewStatus), req.Reason, principal.UserID)
 	if err != nil {
 		return nil, err
 	}
@@ -112,32 +157,134 @@ func (h *handler) UpdateOrderStatus(ctx context.Context, req *order.UpdateOrderS
 }
 
 func (h *handler) CancelOrder(ctx context.Context, req *order.CancelOrderRequest) (*order.CancelOrderResponse, error) {
-	o, err := h.uc.CancelOrder(ctx, req.OrderId, req.Reason)
+	principal, _ := entity.PrincipalFromContext(ctx)
+	o, err := h.uc.CancelOrder(ctx, req.OrderId, req.Reason, principal.UserID)
 	if err != nil {
 		return nil, err
 	}
 	return &order.CancelOrderResponse{Order: mapOrderToProto(o)}, nil
 }
 
+func (h *handler) GetOrderHistory(ctx context.Context, req *order.GetOrderHistoryRequest) (*order.GetOrderHistoryResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	changes, err := h.uc.GetOrderHistory(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*order.OrderStatusChange, len(changes))
+	for i, c := range changes {
+		res[i] = &order.OrderStatusChange{
+			FromStatus: order.OrderStatus(c.From),
+			ToStatus:   order.OrderStatus(c.To),
+			Reason:     c.Reason,
+			ChangedBy:  c.ChangedBy,
+			ChangedAt:  c.ChangedAt,
+		}
+	}
+	return &order.GetOrderHistoryResponse{Changes: res}, nil
+}
+
+func (h *handler) WatchOrder(req *order.WatchOrderRequest, stream order.OrderService_WatchOrderServer) error {
+	if err := req.Validate(); err != nil {
+		return err
+	}
+	return h.uc.WatchOrder(stream.Context(), req.OrderId, func(o *entity.Order) error {
+		return stream.Send(&order.WatchOrderResponse{Order: mapOrderToProto(o)})
+	})
+}
+
 func mapOrderToProto(o *entity.Order) *order.Order {
 	items := make([]*order.OrderItem, len(o.Items))
 	for i, it := range o.Items {
 		items[i] = &order.OrderItem{
 			MenuItemId: it.MenuItemID,
 			Quantity:   it.Quantity,
-			UnitPrice:  it.UnitPrice,
+			UnitPrice:  mapMoneyToProto(it.UnitPrice),
 		}
 	}
 
+	var pickupTime *timestamppb.Timestamp
+	if !o.PickupTime.IsZero() {
+		pickupTime = timestamppb.New(o.PickupTime)
+	}
+
 	return &order.Order{
 		Id:           o.ID,
 		UserId:       o.UserID,
 		RestaurantId: o.RestaurantID,
 		Status:       order.OrderStatus(o.Status),
-		TotalAmount:  o.TotalAmount,
+		TotalAmount:  mapMoneyToProto(o.TotalAmount),
 		Items:        items,
 		CreatedAt:    o.CreatedAt,
 		UpdatedAt:    o.UpdatedAt,
 		PickUp:       o.PickUp,
+		PickupTime:   pickupTime,
+	}
+}
+
+func mapViewFromProto(v order.OrderView) entity.OrderView {
+	if v == order.OrderView_ORDER_VIEW_FULL {
+		return entity.OrderViewFull
+	}
+	return entity.OrderViewBasic
+}
+
+func mapFilterFromProto(f *order.OrderFilter) entity.OrderFilter {
+	if f == nil {
+		return entity.OrderFilter{}
+	}
+
+	statuses := make([]entity.OrderStatus, len(f.Statuses))
+	for i, s := range f.Statuses {
+		statuses[i] = entity.OrderStatus(s)
+	}
+
+	filter := entity.OrderFilter{
+		CustomerPhone: f.CustomerPhone,
+		PlaceID:       f.PlaceId,
+		Statuses:      statuses,
+	}
+	if f.CreatedFrom != nil {
+		filter.CreatedFrom = f.CreatedFrom.AsTime()
+	}
+	if f.CreatedTo != nil {
+		filter.CreatedTo = f.CreatedTo.AsTime()
+	}
+	if f.MinTotalAmount != nil {
+		filter.MinTotalAmount = &f.MinTotalAmount.Value
+	}
+	if f.MaxTotalAmount != nil {
+		filter.MaxTotalAmount = &f.MaxTotalAmount.Value
+	}
+	if f.PickUp != nil {
+		filter.PickUp = &f.PickUp.Value
+	}
+	return filter
+}
+
+func mapSortFromProto(s *order.OrderSort) entity.OrderSort {
+	sort := entity.OrderSort{Ascending: s.GetAscending()}
+	switch s.GetField() {
+	case order.OrderSortField_ORDER_SORT_FIELD_TOTAL_AMOUNT:
+		sort.Field = entity.OrderSortByTotalAmount
+	case order.OrderSortField_ORDER_SORT_FIELD_PICKUP_TIME:
+		sort.Field = entity.OrderSortByPickupTime
+	default:
+		sort.Field = entity.OrderSortByCreatedAt
+	}
+	return sort
+}
+
+func mapMoneyFromProto(m *money.Money) entity.Money {
+	return entity.NewMoney(m.GetAmount(), m.GetCurrencyCode())
+}
+
+func mapMoneyToProto(m entity.Money) *money.Money {
+	return &money.Money{
+		Amount:       m.Amount,
+		CurrencyCode: m.Currency,
 	}
 }
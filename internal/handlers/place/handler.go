@@ -0,0 +1,163 @@
+package place
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Tortik3000/service-order/generated/api/place"
+	"github.com/Tortik3000/service-order/internal/domain/entity"
+)
+
+type Handler interface {
+	CreatePlace(ctx context.Context, req *place.CreatePlaceRequest) (*place.CreatePlaceResponse, error)
+	GetPlace(ctx context.Context, req *place.GetPlaceRequest) (*place.GetPlaceResponse, error)
+	ListPlaces(ctx context.Context, req *place.ListPlacesRequest) (*place.ListPlacesResponse, error)
+	UpdatePlace(ctx context.Context, req *place.UpdatePlaceRequest) (*place.UpdatePlaceResponse, error)
+	ListAvailablePickupSlots(ctx context.Context, req *place.ListAvailablePickupSlotsRequest) (*place.ListAvailablePickupSlotsResponse, error)
+}
+
+type (
+	placeUseCase interface {
+		CreatePlace(ctx context.Context, place *entity.Place) error
+		GetPlace(ctx context.Context, id string) (*entity.Place, error)
+		ListPlaces(ctx context.Context, activeOnly bool) ([]entity.Place, error)
+		UpdatePlace(ctx context.Context, place *entity.Place) error
+		ListAvailablePickupSlots(ctx context.Context, placeID, date string) ([]entity.PickupSlot, error)
+	}
+)
+
+type handler struct {
+	place.UnimplementedPlaceServiceServer
+	uc placeUseCase
+}
+
+var _ Handler = (*handler)(nil)
+
+func NewPlaceHandler(u placeUseCase) *handler {
+	return &handler{uc: u}
+}
+
+func (h *handler) CreatePlace(ctx context.Context, req *place.CreatePlaceRequest) (*place.CreatePlaceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	p := &entity.Place{
+		Name:               req.Name,
+		Address:            req.Address,
+		Latitude:           req.Latitude,
+		Longitude:          req.Longitude,
+		OpeningHours:       mapOpeningHoursFromProto(req.OpeningHours),
+		Active:             true,
+		Timezone:           req.Timezone,
+		PickupSlotMinutes:  req.PickupSlotMinutes,
+		PickupSlotCapacity: req.PickupSlotCapacity,
+	}
+	if err := h.uc.CreatePlace(ctx, p); err != nil {
+		return nil, err
+	}
+	return &place.CreatePlaceResponse{Place: mapPlaceToProto(p)}, nil
+}
+
+func (h *handler) GetPlace(ctx context.Context, req *place.GetPlaceRequest) (*place.GetPlaceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	p, err := h.uc.GetPlace(ctx, req.PlaceId)
+	if err != nil {
+		return nil, err
+	}
+	return &place.GetPlaceResponse{Place: mapPlaceToProto(p)}, nil
+}
+
+func (h *handler) ListPlaces(ctx context.Context, req *place.ListPlacesRequest) (*place.ListPlacesResponse, error) {
+	places, err := h.uc.ListPlaces(ctx, req.ActiveOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*place.Place, len(places))
+	for i, p := range places {
+		res[i] = mapPlaceToProto(&p)
+	}
+	return &place.ListPlacesResponse{Places: res}, nil
+}
+
+func (h *handler) UpdatePlace(ctx context.Context, req *place.UpdatePlaceRequest) (*place.UpdatePlaceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	p := &entity.Place{
+		ID:                 req.Id,
+		Name:               req.Name,
+		Address:            req.Address,
+		Latitude:           req.Latitude,
+		Longitude:          req.Longitude,
+		OpeningHours:       mapOpeningHoursFromProto(req.OpeningHours),
+		Active:             req.Active,
+		Timezone:           req.Timezone,
+		PickupSlotMinutes:  req.PickupSlotMinutes,
+		PickupSlotCapacity: req.PickupSlotCapacity,
+	}
+	if err := h.uc.UpdatePlace(ctx, p); err != nil {
+		return nil, err
+	}
+	return &place.UpdatePlaceResponse{Place: mapPlaceToProto(p)}, nil
+}
+
+func (h *handler) ListAvailablePickupSlots(ctx context.Context, req *place.ListAvailablePickupSlotsRequest) (*place.ListAvailablePickupSlotsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	slots, err := h.uc.ListAvailablePickupSlots(ctx, req.PlaceId, req.Date)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*place.PickupSlot, len(slots))
+	for i, s := range slots {
+		res[i] = &place.PickupSlot{
+			StartTime: timestamppb.New(s.Start),
+			EndTime:   timestamppb.New(s.End),
+			Capacity:  s.Capacity,
+			Remaining: s.Remaining,
+		}
+	}
+	return &place.ListAvailablePickupSlotsResponse{Slots: res}, nil
+}
+
+func mapOpeningHoursFromProto(hours []*place.OpeningHours) []entity.OpeningHours {
+	res := make([]entity.OpeningHours, len(hours))
+	for i, h := range hours {
+		res[i] = entity.OpeningHours{
+			DayOfWeek: h.DayOfWeek,
+			OpensAt:   h.OpensAt,
+			ClosesAt:  h.ClosesAt,
+		}
+	}
+	return res
+}
+
+func mapPlaceToProto(p *entity.Place) *place.Place {
+	hours := make([]*place.OpeningHours, len(p.OpeningHours))
+	for i, h := range p.OpeningHours {
+		hours[i] = &place.OpeningHours{
+			DayOfWeek: h.DayOfWeek,
+			OpensAt:   h.OpensAt,
+			ClosesAt:  h.ClosesAt,
+		}
+	}
+
+	return &place.Place{
+		Id:                 p.ID,
+		Name:               p.Name,
+		Address:            p.Address,
+		Latitude:           p.Latitude,
+		Longitude:          p.Longitude,
+		OpeningHours:       hours,
+		Active:             p.Active,
+		Timezone:           p.Timezone,
+		PickupSlotMinutes:  p.PickupSlotMinutes,
+		PickupSlotCapacity: p.PickupSlotCapacity,
+	}
+}
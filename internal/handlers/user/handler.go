@@ -2,6 +2,9 @@ package user
 
 import (
 	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/Tortik3000/service-order/generated/api/user"
 	"github.com/Tortik3000/service-order/internal/domain/entity"
@@ -9,23 +12,42 @@ import (
 
 type Handler interface {
 	RegisterUser(ctx context.Context, req *user.RegisterUserRequest) (*user.RegisterUserResponse, error)
+	GetUser(ctx context.Context, req *user.GetUserRequest) (*user.GetUserResponse, error)
+	GetUserByPhone(ctx context.Context, req *user.GetUserByPhoneRequest) (*user.GetUserByPhoneResponse, error)
+	UpdateUser(ctx context.Context, req *user.UpdateUserRequest) (*user.UpdateUserResponse, error)
+	RequestOtp(ctx context.Context, req *user.RequestOtpRequest) (*user.RequestOtpResponse, error)
+	VerifyOtp(ctx context.Context, req *user.VerifyOtpRequest) (*user.VerifyOtpResponse, error)
+	RefreshToken(ctx context.Context, req *user.RefreshTokenRequest) (*user.RefreshTokenResponse, error)
 }
 
 type (
 	userUseCase interface {
 		RegisterUser(ctx context.Context, phone string, name string) (*entity.User, error)
+		GetUser(ctx context.Context, id string) (*entity.User, error)
+		GetUserByPhone(ctx context.Context, phone string) (*entity.User, error)
+		UpdateUser(ctx context.Context, user *entity.User) error
+	}
+
+	authUseCase interface {
+		RequestOtp(ctx context.Context, phone string) (time.Time, error)
+		VerifyOtp(ctx context.Context, phone, code string) (*entity.User, *entity.Tokens, error)
+		RefreshTokens(ctx context.Context, refreshToken string) (*entity.Tokens, error)
 	}
 )
 
 type handler struct {
 	user.UnimplementedUserServiceServer
-	uc userUseCase
+	uc     userUseCase
+	authUC authUseCase
 }
 
 var _ Handler = (*handler)(nil)
 
-func NewUserHandler(u userUseCase) *handler {
-	return &handler{uc: u}
+func NewUserHandler(u userUseCase, a authUseCase) *handler {
+	return &handler{
+		uc:     u,
+		authUC: a,
+	}
 }
 
 func (h *handler) RegisterUser(ctx context.Context, req *user.RegisterUserRequest) (*user.RegisterUserResponse, error) {
@@ -39,13 +61,98 @@ func (h *handler) RegisterUser(ctx context.Context, req *user.RegisterUserReques
 	return &user.RegisterUserResponse{User: mapUserToProto(u)}, nil
 }
 
+func (h *handler) GetUser(ctx context.Context, req *user.GetUserRequest) (*user.GetUserResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	u, err := h.uc.GetUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &user.GetUserResponse{User: mapUserToProto(u)}, nil
+}
+
+func (h *handler) GetUserByPhone(ctx context.Context, req *user.GetUserByPhoneRequest) (*user.GetUserByPhoneResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	u, err := h.uc.GetUserByPhone(ctx, req.Phone)
+	if err != nil {
+		return nil, err
+	}
+	return &user.GetUserByPhoneResponse{User: mapUserToProto(u)}, nil
+}
+
+func (h *handler) UpdateUser(ctx context.Context, req *user.UpdateUserRequest) (*user.UpdateUserResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	u := &entity.User{
+		ID:               req.Id,
+		Name:             req.Name,
+		Email:            req.Email,
+		MarketingConsent: req.MarketingConsent,
+	}
+	if err := h.uc.UpdateUser(ctx, u); err != nil {
+		return nil, err
+	}
+	return &user.UpdateUserResponse{User: mapUserToProto(u)}, nil
+}
+
+func (h *handler) RequestOtp(ctx context.Context, req *user.RequestOtpRequest) (*user.RequestOtpResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	expiresAt, err := h.authUC.RequestOtp(ctx, req.Phone)
+	if err != nil {
+		return nil, err
+	}
+	return &user.RequestOtpResponse{ExpiresAt: timestamppb.New(expiresAt)}, nil
+}
+
+func (h *handler) VerifyOtp(ctx context.Context, req *user.VerifyOtpRequest) (*user.VerifyOtpResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	u, tokens, err := h.authUC.VerifyOtp(ctx, req.Phone, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	return &user.VerifyOtpResponse{
+		User:   mapUserToProto(u),
+		Tokens: mapTokensToProto(tokens),
+	}, nil
+}
+
+func (h *handler) RefreshToken(ctx context.Context, req *user.RefreshTokenRequest) (*user.RefreshTokenResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	tokens, err := h.authUC.RefreshTokens(ctx, req.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	return &user.RefreshTokenResponse{Tokens: mapTokensToProto(tokens)}, nil
+}
+
+func mapTokensToProto(t *entity.Tokens) *user.Tokens {
+	return &user.Tokens{
+		AccessToken:          t.AccessToken,
+		AccessTokenExpiresAt: timestamppb.New(t.AccessTokenExpiresAt),
+		RefreshToken:         t.RefreshToken,
+	}
+}
+
 func mapUserToProto(u *entity.User) *user.User {
 	if u == nil {
 		return nil
 	}
 	return &user.User{
-		Id:    u.ID,
-		Phone: u.Phone,
-		Name:  u.Name,
+		Id:               u.ID,
+		Phone:            u.Phone,
+		Name:             u.Name,
+		Email:            u.Email,
+		MarketingConsent: u.MarketingConsent,
+		Role:             user.Role(u.Role),
 	}
 }
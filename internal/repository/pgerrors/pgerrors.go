@@ -0,0 +1,108 @@
+package pgerrors
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/Tortik3000/service-order/internal/domain/entity"
+)
+
+// SQLSTATE codes, see https://www.postgresql.org/docs/current/errcodes-appendix.html.
+const (
+	codeInvalidTextRepresentation = "22P02"
+	codeNotNullViolation          = "23502"
+	codeForeignKeyViolation       = "23503"
+	codeUniqueViolation           = "23505"
+	codeCheckViolation            = "23514"
+	codeSerializationFailure      = "40001"
+	codeDeadlockDetected          = "40P01"
+	codeAdminShutdown             = "57P01"
+	codeCrashShutdown             = "57P02"
+	codeCannotConnectNow          = "57P03"
+	classConnectionException      = "08"
+)
+
+// Translate converts constraint and input errors reported by Postgres into
+// domain errors. Any other error is returned unchanged.
+func Translate(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	var domainErr *entity.Error
+	switch pgErr.Code {
+	case codeUniqueViolation:
+		domainErr = entity.NewAlreadyExistsError("UNIQUE_VIOLATION", "a record with the same unique value already exists")
+	case codeForeignKeyViolation:
+		domainErr = entity.NewInvalidArgumentError("REFERENCE_NOT_FOUND", "a referenced record does not exist")
+	case codeNotNullViolation:
+		domainErr = entity.NewInvalidArgumentError("REQUIRED_VALUE_MISSING", "a required value is missing")
+	case codeCheckViolation:
+		domainErr = entity.NewInvalidArgumentError("CHECK_VIOLATION", "a value does not satisfy a constraint")
+	case codeInvalidTextRepresentation:
+		domainErr = entity.NewInvalidArgumentError("INVALID_VALUE", "a value has an invalid format")
+	case codeSerializationFailure, codeDeadlockDetected:
+		domainErr = entity.NewConflictError("CONCURRENT_UPDATE", "the record was modified concurrently, retry the request")
+	default:
+		return err
+	}
+
+	if pgErr.TableName != "" {
+		domainErr.WithMetadata("table", pgErr.TableName)
+	}
+	if pgErr.ConstraintName != "" {
+		domainErr.WithMetadata("constraint", pgErr.ConstraintName)
+	}
+	if pgErr.ColumnName != "" {
+		domainErr.WithMetadata("column", pgErr.ColumnName)
+	}
+
+	return domainErr.WithCause(err)
+}
+
+// Retryable reports whether a transaction that failed with err can be run
+// again: Postgres aborted it because of a serialization failure or a
+// deadlock, or the connection was lost or refused. Cancellation of the
+// context is never retryable.
+func Retryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if Conflict(err) {
+		return true
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case codeAdminShutdown, codeCrashShutdown, codeCannotConnectNow:
+			return true
+		}
+		return strings.HasPrefix(pgErr.Code, classConnectionException)
+	}
+
+	var connectErr *pgconn.ConnectError
+	var netErr net.Error
+	return pgconn.SafeToRetry(err) ||
+		errors.As(err, &connectErr) ||
+		errors.As(err, &netErr) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// Conflict reports whether Postgres aborted a transaction because of a
+// serialization failure or a deadlock. Such a transaction was rolled back
+// even if the error came from its commit.
+func Conflict(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+	return pgErr.Code == codeSerializationFailure || pgErr.Code == codeDeadlockDetected
+}
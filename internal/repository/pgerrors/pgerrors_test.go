@@ -0,0 +1,54 @@
+package pgerrors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func TestRetryableAndConflict(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		retryable bool
+		conflict  bool
+	}{
+		{name: "nil", err: nil},
+		{name: "plain error", err: errors.New("boom")},
+		{name: "canceled", err: context.Canceled},
+		{name: "deadline exceeded", err: fmt.Errorf("query: %w", context.DeadlineExceeded)},
+		{name: "serialization failure", err: &pgconn.PgError{Code: codeSerializationFailure}, retryable: true, conflict: true},
+		{name: "deadlock", err: &pgconn.PgError{Code: codeDeadlockDetected}, retryable: true, conflict: true},
+		{
+			name:      "translated serialization failure",
+			err:       fmt.Errorf("update order: %w", Translate(&pgconn.PgError{Code: codeSerializationFailure})),
+			retryable: true,
+			conflict:  true,
+		},
+		{name: "admin shutdown", err: &pgconn.PgError{Code: codeAdminShutdown}, retryable: true},
+		{name: "crash shutdown", err: &pgconn.PgError{Code: codeCrashShutdown}, retryable: true},
+		{name: "cannot connect now", err: &pgconn.PgError{Code: codeCannotConnectNow}, retryable: true},
+		{name: "connection failure", err: &pgconn.PgError{Code: "08006"}, retryable: true},
+		{name: "unique violation", err: &pgconn.PgError{Code: codeUniqueViolation}},
+		{name: "translated unique violation", err: Translate(&pgconn.PgError{Code: codeUniqueViolation})},
+		{name: "eof", err: fmt.Errorf("read: %w", io.EOF), retryable: true},
+		{name: "unexpected eof", err: io.ErrUnexpectedEOF, retryable: true},
+		{name: "network error", err: &net.OpError{Op: "read", Err: errors.New("connection reset")}, retryable: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Retryable(tt.err); got != tt.retryable {
+				t.Errorf("Retryable(%v) = %v, want %v", tt.err, got, tt.retryable)
+			}
+			if got := Conflict(tt.err); got != tt.conflict {
+				t.Errorf("Conflict(%v) = %v, want %v", tt.err, got, tt.conflict)
+			}
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/Tortik3000/service-order/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Tortik3000/service-order/internal/domain/entity"
+	"github.com/Tortik3000/service-order/internal/repository/pgerrors"
+)
+
+const (
+	keyTable       = "idempotency_key"
+	keyUserID      = "user_id"
+	keyKey         = "key"
+	keyMethod      = "method"
+	keyRequestHash = "request_hash"
+	keyResponse    = "response"
+	keyCompleted   = "completed"
+	keyCreatedAt   = "created_at"
+	keyExpiresAt   = "expires_at"
+)
+
+type Repository interface {
+	Reserve(ctx context.Context, key *entity.IdempotencyKey, staleAfter time.Duration) (bool, error)
+	Get(ctx context.Context, userID, key, method string) (*entity.IdempotencyKey, error)
+	MarkCompleted(ctx context.Context, userID, key, method string) error
+	SaveResponse(ctx context.Context, userID, key, method string, response []byte) error
+	Release(ctx context.Context, userID, key, method string) error
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+type (
+	txManager interface {
+		GetConn(ctx context.Context) (postgres.Conn, error)
+	}
+)
+
+type repository struct {
+	transactor   txManager
+	queryBuilder sq.StatementBuilderType
+}
+
+var _ Repository = (*repository)(nil)
+
+func New(transactor txManager) *repository {
+	return &repository{
+		transactor:   transactor,
+		queryBuilder: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+// Reserve stores a new key without a response and reports whether it did.
+// An existing key is taken over only if it has expired, or if its request
+// has not completed and it is older than staleAfter, which means the
+// request that reserved it never finished.
+func (r *repository) Reserve(ctx context.Context, key *entity.IdempotencyKey, staleAfter time.Duration) (bool, error) {
+	query := r.queryBuilder.
+		Insert(keyTable).
+		Columns(keyUserID, keyKey, keyMethod, keyRequestHash, keyExpiresAt).
+		Values(key.UserID, key.Key, key.Method, key.RequestHash, key.ExpiresAt).
+		Suffix(fmt.Sprintf(
+			`ON CONFLICT (%[8]s, %[1]s, %[2]s) DO UPDATE
+			SET %[3]s = EXCLUDED.%[3]s, %[4]s = NULL, %[9]s = FALSE, %[5]s = NOW(), %[6]s = EXCLUDED.%[6]s
+			WHERE %[7]s.%[6]s < NOW() OR (%[7]s.%[4]s IS NULL AND NOT %[7]s.%[9]s AND %[7]s.%[5]s < ?)
+			RETURNING %[1]s`,
+			keyKey, keyMethod, keyRequestHash, keyResponse, keyCreatedAt, keyExpiresAt, keyTable, keyUserID, keyCompleted,
+		), time.Now().Add(-staleAfter))
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("build reserve idempotency key query: %w", err)
+	}
+
+	conn, err := r.transactor.GetConn(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	var reserved string
+	err = conn.QueryRow(ctx, sql, args...).Scan(&reserved)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("insert idempotency key: %w", pgerrors.Translate(err))
+	}
+
+	return true, nil
+}
+
+func (r *repository) Get(ctx context.Context, userID, key, method string) (*entity.IdempotencyKey, error) {
+	query := r.queryBuilder.
+		Select(keyUserID, keyKey, keyMethod, keyRequestHash, keyResponse, keyCompleted, keyExpiresAt).
+		From(keyTable).
+		Where(sq.Eq{keyUserID: userID, keyKey: key, keyMethod: method})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build get idempotency key query: %w", err)
+	}
+
+	conn, err := r.transactor.GetConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &entity.IdempotencyKey{}
+	err = conn.QueryRow(ctx, sql, args...).Scan(&res.UserID, &res.Key, &res.Method, &res.RequestHash, &res.Response, &res.Completed, &res.ExpiresAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entity.NewNotFoundError("IDEMPOTENCY_KEY_NOT_FOUND", "idempotency key not found")
+		}
+		return nil, fmt.Errorf("scan idempotency key: %w", pgerrors.Translate(err))
+	}
+
+	return res, nil
+}
+
+// MarkCompleted records that the request of the key has committed. It is
+// meant to run in the transaction of that request.
+func (r *repository) MarkCompleted(ctx context.Context, userID, key, method string) error {
+	query := r.queryBuilder.
+		Update(keyTable).
+		Set(keyCompleted, true).
+		Where(sq.Eq{keyUserID: userID, keyKey: key, keyMethod: method})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("build mark idempotency key completed query: %w", err)
+	}
+
+	conn, err := r.transactor.GetConn(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("update idempotency key: %w", pgerrors.Translate(err))
+	}
+
+	return nil
+}
+
+func (r *repository) SaveResponse(ctx context.Context, userID, key, method string, response []byte) error {
+	query := r.queryBuilder.
+		Update(keyTable).
+		Set(keyResponse, response).
+		Set(keyCompleted, true).
+		Where(sq.Eq{keyUserID: userID, keyKey: key, keyMethod: method})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("build save idempotency response query: %w", err)
+	}
+
+	conn, err := r.transactor.GetConn(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("update idempotency key: %w", pgerrors.Translate(err))
+	}
+
+	return nil
+}
+
+// Release deletes a key whose request failed, so it can be retried. A key
+// whose request has completed is kept: running the request again would
+// repeat its changes.
+func (r *repository) Release(ctx context.Context, userID, key, method string) error {
+	query := r.queryBuilder.
+		Delete(keyTable).
+		Where(sq.Eq{keyUserID: userID, keyKey: key, keyMethod: method, keyCompleted: false})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("build release idempotency key query: %w", err)
+	}
+
+	conn, err := r.transactor.GetConn(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("delete idempotency key: %w", pgerrors.Translate(err))
+	}
+
+	return nil
+}
+
+func (r *repository) DeleteExpired(ctx context.Context) (int64, error) {
+	query := r.queryBuilder.
+		Delete(keyTable).
+		Where(fmt.Sprintf("%s < NOW()", keyExpiresAt))
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("build delete expired idempotency keys query: %w", err)
+	}
+
+	conn, err := r.transactor.GetConn(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	tag, err := conn.Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, fmt.Errorf("delete expired idempotency keys: %w", pgerrors.Translate(err))
+	}
+
+	return tag.RowsAffected(), nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/jackc/pgx/v5"
 
 	"github.com/Tortik3000/service-order/internal/domain/entity"
+	"github.com/Tortik3000/service-order/internal/repository/pgerrors"
 )
 
 const (
@@ -24,6 +25,7 @@ const (
 	itemName        = "name"
 	itemDescription = "description"
 	itemPrice       = "price"
+	itemCurrency    = "currency"
 	itemActive      = "active"
 	itemImageURL    = "image_url"
 )
@@ -33,6 +35,7 @@ type Repository interface {
 	GetItemsByCategory(ctx context.Context, categoryID string) ([]entity.MenuItem, error)
 	CreateCategory(ctx context.Context, category *entity.Category) error
 	GetMenuItem(ctx context.Context, id string) (*entity.MenuItem, error)
+	GetMenuItemsByIDs(ctx context.Context, ids []string) ([]entity.MenuItem, error)
 	CreateMenuItem(ctx context.Context, item *entity.MenuItem) error
 	UpdateMenuItem(ctx context.Context, item *entity.MenuItem) error
 }
@@ -77,9 +80,10 @@ func (r *repository) GetCategory(ctx context.Context, id string) (*entity.Catego
 	err = conn.QueryRow(ctx, sql, args...).Scan(&cat.ID, &cat.Name, &cat.SortOrder)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil
+			return nil, entity.NewNotFoundError("CATEGORY_NOT_FOUND", "menu category not found").
+				WithMetadata("category_id", id)
 		}
-		return nil, fmt.Errorf("scan category: %w", err)
+		return nil, fmt.Errorf("scan category: %w", pgerrors.Translate(err))
 	}
 
 	return cat, nil
@@ -87,7 +91,7 @@ func (r *repository) GetCategory(ctx context.Context, id string) (*entity.Catego
 
 func (r *repository) GetItemsByCategory(ctx context.Context, catID string) ([]entity.MenuItem, error) {
 	query := r.queryBuilder.
-		Select(itemID, itemCategoryID, itemName, itemDescription, itemPrice, itemActive, itemImageURL).
+		Select(itemID, itemCategoryID, itemName, itemDescription, itemPrice, itemCurrency, itemActive, itemImageURL).
 		From(itemTable).
 		Where(sq.Eq{itemCategoryID: catID})
 
@@ -103,26 +107,29 @@ func (r *repository) GetItemsByCategory(ctx context.Context, catID string) ([]en
 
 	rows, err := conn.Query(ctx, sql, args...)
 	if err != nil {
-		return nil, fmt.Errorf("query items: %w", err)
+		return nil, fmt.Errorf("query items: %w", pgerrors.Translate(err))
 	}
 	defer rows.Close()
 
 	var items []entity.MenuItem
 	for rows.Next() {
 		var item entity.MenuItem
-		err := rows.Scan(&item.ID, &item.CategoryID, &item.Name, &item.Description, &item.Price, &item.Active, &item.ImageURL)
+		err := rows.Scan(&item.ID, &item.CategoryID, &item.Name, &item.Description, &item.Price.Amount, &item.Price.Currency, &item.Active, &item.ImageURL)
 		if err != nil {
-			return nil, fmt.Errorf("scan item: %w", err)
+			return nil, fmt.Errorf("scan item: %w", pgerrors.Translate(err))
 		}
 		items = append(items, item)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate items: %w", pgerrors.Translate(err))
+	}
 
 	return items, nil
 }
 
 func (r *repository) GetMenuItem(ctx context.Context, id string) (*entity.MenuItem, error) {
 	query := r.queryBuilder.
-		Select(itemID, itemCategoryID, itemName, itemDescription, itemPrice, itemActive, itemImageURL).
+		Select(itemID, itemCategoryID, itemName, itemDescription, itemPrice, itemCurrency, itemActive, itemImageURL).
 		From(itemTable).
 		Where(sq.Eq{itemID: id})
 
@@ -137,22 +144,67 @@ func (r *repository) GetMenuItem(ctx context.Context, id string) (*entity.MenuIt
 	}
 
 	item := &entity.MenuItem{}
-	err = conn.QueryRow(ctx, sql, args...).Scan(&item.ID, &item.CategoryID, &item.Name, &item.Description, &item.Price, &item.Active, &item.ImageURL)
+	err = conn.QueryRow(ctx, sql, args...).Scan(&item.ID, &item.CategoryID, &item.Name, &item.Description, &item.Price.Amount, &item.Price.Currency, &item.Active, &item.ImageURL)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil
+			return nil, entity.NewNotFoundError("MENU_ITEM_NOT_FOUND", "menu item not found").
+				WithMetadata("menu_item_id", id)
 		}
-		return nil, fmt.Errorf("scan menu item: %w", err)
+		return nil, fmt.Errorf("scan menu item: %w", pgerrors.Translate(err))
 	}
 
 	return item, nil
 }
 
+// GetMenuItemsByIDs returns the menu items with the given ids in a single
+// query. Unknown ids are skipped, so the result may be shorter than ids.
+func (r *repository) GetMenuItemsByIDs(ctx context.Context, ids []string) ([]entity.MenuItem, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	query := r.queryBuilder.
+		Select(itemID, itemCategoryID, itemName, itemDescription, itemPrice, itemCurrency, itemActive, itemImageURL).
+		From(itemTable).
+		Where(sq.Expr(fmt.Sprintf("%s = ANY(?)", itemID), ids))
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build get menu items by ids query: %w", err)
+	}
+
+	conn, err := r.transactor.GetConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query menu items: %w", pgerrors.Translate(err))
+	}
+	defer rows.Close()
+
+	items := make([]entity.MenuItem, 0, len(ids))
+	for rows.Next() {
+		var item entity.MenuItem
+		err := rows.Scan(&item.ID, &item.CategoryID, &item.Name, &item.Description, &item.Price.Amount, &item.Price.Currency, &item.Active, &item.ImageURL)
+		if err != nil {
+			return nil, fmt.Errorf("scan menu item: %w", pgerrors.Translate(err))
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate menu items: %w", pgerrors.Translate(err))
+	}
+
+	return items, nil
+}
+
 func (r *repository) CreateMenuItem(ctx context.Context, item *entity.MenuItem) error {
 	query := r.queryBuilder.
 		Insert(itemTable).
-		Columns(itemCategoryID, itemName, itemDescription, itemPrice, itemActive, itemImageURL).
-		Values(item.CategoryID, item.Name, item.Description, item.Price, item.Active, item.ImageURL).
+		Columns(itemCategoryID, itemName, itemDescription, itemPrice, itemCurrency, itemActive, itemImageURL).
+		Values(item.CategoryID, item.Name, item.Description, item.Price.Amount, item.Price.Currency, item.Active, item.ImageURL).
 		Suffix("RETURNING id")
 
 	sql, args, err := query.ToSql()
@@ -167,7 +219,7 @@ func (r *repository) CreateMenuItem(ctx context.Context, item *entity.MenuItem)
 
 	err = conn.QueryRow(ctx, sql, args...).Scan(&item.ID)
 	if err != nil {
-		return fmt.Errorf("insert menu item: %w", err)
+		return fmt.Errorf("insert menu item: %w", pgerrors.Translate(err))
 	}
 
 	return nil
@@ -179,7 +231,8 @@ func (r *repository) UpdateMenuItem(ctx context.Context, item *entity.MenuItem)
 		Set(itemCategoryID, item.CategoryID).
 		Set(itemName, item.Name).
 		Set(itemDescription, item.Description).
-		Set(itemPrice, item.Price).
+		Set(itemPrice, item.Price.Amount).
+		Set(itemCurrency, item.Price.Currency).
 		Set(itemActive, item.Active).
 		Set(itemImageURL, item.ImageURL).
 		Where(sq.Eq{itemID: item.ID})
@@ -194,9 +247,13 @@ func (r *repository) UpdateMenuItem(ctx context.Context, item *entity.MenuItem)
 		return err
 	}
 
-	_, err = conn.Exec(ctx, sql, args...)
+	tag, err := conn.Exec(ctx, sql, args...)
 	if err != nil {
-		return fmt.Errorf("update menu item: %w", err)
+		return fmt.Errorf("update menu item: %w", pgerrors.Translate(err))
+	}
+	if tag.RowsAffected() == 0 {
+		return entity.NewNotFoundError("MENU_ITEM_NOT_FOUND", "menu item not found").
+			WithMetadata("menu_item_id", item.ID)
 	}
 
 	return nil
@@ -221,7 +278,7 @@ func (r *repository) CreateCategory(ctx context.Context, category *entity.Catego
 
 	err = conn.QueryRow(ctx, sql, args...).Scan(&category.ID)
 	if err != nil {
-		return fmt.Errorf("insert category: %w", err)
+		return fmt.Errorf("insert category: %w", pgerrors.Translate(err))
 	}
 
 	return nil
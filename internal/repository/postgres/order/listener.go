@@ -0,0 +1,179 @@
+package order
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/Tortik3000/service-order/pkg/logger"
+)
+
+const (
+	// statusChannel is the notification channel UpdateStatus notifies with
+	// a statusNotification of the changed order.
+	statusChannel = "order_status"
+
+	listenRetryInterval = time.Second
+)
+
+type statusNotification struct {
+	OrderID string `json:"order_id"`
+	PlaceID string `json:"place_id"`
+}
+
+type StatusListener interface {
+	Run(ctx context.Context)
+	Subscribe(orderID string) (<-chan struct{}, func())
+	SubscribePlace(placeID string) (<-chan struct{}, func())
+}
+
+// statusListener holds a dedicated connection that LISTENs on statusChannel
+// and wakes the subscribers of the changed order and of its place. Every
+// replica runs its own listener, so status changes made through any replica
+// reach all of them.
+type statusListener struct {
+	pool *pgxpool.Pool
+	logs logger.Logger
+
+	mu     sync.Mutex
+	orders subscribers
+	places subscribers
+}
+
+// subscribers maps an order or place ID to the channels subscribed to it.
+type subscribers map[string]map[chan struct{}]struct{}
+
+var _ StatusListener = (*statusListener)(nil)
+
+func NewStatusListener(pool *pgxpool.Pool, logs logger.Logger) *statusListener {
+	return &statusListener{
+		pool:   pool,
+		logs:   logs,
+		orders: make(subscribers),
+		places: make(subscribers),
+	}
+}
+
+// Run blocks until ctx is cancelled, reconnecting whenever the connection
+// is lost.
+func (l *statusListener) Run(ctx context.Context) {
+	for {
+		err := l.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		l.logs.Error("order status listener failed", logger.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(listenRetryInterval):
+		}
+	}
+}
+
+// Subscribe returns a channel that receives a value after every status
+// change of the order, and a function that must be called to unsubscribe.
+// Changes that happen while the previous value has not been received yet
+// are coalesced, so subscribers should reread the order on every wake-up.
+func (l *statusListener) Subscribe(orderID string) (<-chan struct{}, func()) {
+	return l.subscribe(l.orders, orderID)
+}
+
+// SubscribePlace is like Subscribe, but for status changes of any order of
+// the place.
+func (l *statusListener) SubscribePlace(placeID string) (<-chan struct{}, func()) {
+	return l.subscribe(l.places, placeID)
+}
+
+func (l *statusListener) subscribe(subs subscribers, id string) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	l.mu.Lock()
+	if subs[id] == nil {
+		subs[id] = make(map[chan struct{}]struct{})
+	}
+	subs[id][ch] = struct{}{}
+	l.mu.Unlock()
+
+	return ch, func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		delete(subs[id], ch)
+		if len(subs[id]) == 0 {
+			delete(subs, id)
+		}
+	}
+}
+
+func (l *statusListener) listen(ctx context.Context) error {
+	poolConn, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("acquire connection: %w", err)
+	}
+	// The connection keeps listening for as long as it lives, so it must
+	// not go back to the pool.
+	conn := poolConn.Hijack()
+	defer conn.Close(context.WithoutCancel(ctx))
+
+	if _, err := conn.Exec(ctx, "LISTEN "+statusChannel); err != nil {
+		return fmt.Errorf("listen %s: %w", statusChannel, err)
+	}
+
+	// Changes made while nobody was listening have been missed; wake every
+	// subscriber so that it rereads its order.
+	l.wakeAll()
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return fmt.Errorf("wait for notification: %w", err)
+		}
+
+		var payload statusNotification
+		if err := json.Unmarshal([]byte(notification.Payload), &payload); err != nil {
+			l.logs.Error("invalid order status notification",
+				logger.NewField("payload", notification.Payload),
+				logger.Error(err),
+			)
+			continue
+		}
+		l.wake(payload)
+	}
+}
+
+func (l *statusListener) wake(payload statusNotification) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for ch := range l.orders[payload.OrderID] {
+		signal(ch)
+	}
+	for ch := range l.places[payload.PlaceID] {
+		signal(ch)
+	}
+}
+
+func (l *statusListener) wakeAll() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, subs := range []subscribers{l.orders, l.places} {
+		for _, chs := range subs {
+			for ch := range chs {
+				signal(ch)
+			}
+		}
+	}
+}
+
+func signal(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
@@ -2,6 +2,8 @@ package order
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -10,15 +12,19 @@ import (
 	"github.com/jackc/pgx/v5"
 
 	"github.com/Tortik3000/service-order/internal/domain/entity"
+	"github.com/Tortik3000/service-order/internal/repository/pgerrors"
 )
 
 const (
 	orderTable       = "orders"
 	orderID          = "id"
 	orderCustomerID  = "customer_id"
+	orderPlaceID     = "place_id"
 	orderStatus      = "status"
 	orderTotalAmount = "total_amount"
+	orderCurrency    = "currency"
 	orderPickUp      = "pick_up"
+	orderPickupTime  = "pickup_time"
 	orderCreatedAt   = "created_at"
 	orderUpdatedAt   = "updated_at"
 
@@ -27,15 +33,52 @@ const (
 	orderItemMenuItemID = "menu_item_id"
 	orderItemQuantity   = "quantity"
 	orderItemUnitPrice  = "unit_price"
+
+	customerTable = "customer"
+	customerID    = "id"
+	customerPhone = "phone"
+
+	historyTable      = "order_status_history"
+	historyID         = "id"
+	historyOrderID    = "order_id"
+	historyFromStatus = "from_status"
+	historyToStatus   = "to_status"
+	historyReason     = "reason"
+	historyChangedBy  = "changed_by"
+	historyCreatedAt  = "created_at"
 )
 
+// placeIDColumn selects orders.place_id, which is NULL for orders created
+// before the place was recorded.
+var placeIDColumn = fmt.Sprintf("COALESCE(%s::text, '')", orderPlaceID)
+
+// orderColumns are the columns read by scanOrder, in order.
+var orderColumns = []string{
+	orderID,
+	orderCustomerID,
+	placeIDColumn,
+	orderStatus,
+	orderTotalAmount,
+	orderCurrency,
+	orderPickUp,
+	orderPickupTime,
+	orderCreatedAt,
+	orderUpdatedAt,
+}
+
 type Repository interface {
 	Create(ctx context.Context, order *entity.Order) error
 	CreateItems(ctx context.Context, orderID string, items []entity.OrderItem) error
 	Get(ctx context.Context, id string) (*entity.Order, error)
-	UpdateStatus(ctx context.Context, id string, status entity.OrderStatus) error
-	ListByUser(ctx context.Context, userID string, limit, offset int32) ([]entity.Order, error)
-	ListByStatus(ctx context.Context, statuses []entity.OrderStatus, limit, offset int32) ([]entity.Order, error)
+	UpdateStatus(ctx context.Context, id string, from, to entity.OrderStatus) error
+	ListByUser(ctx context.Context, userID string, after *entity.OrderCursor, limit int32, view entity.OrderView) ([]entity.Order, *entity.OrderCursor, error)
+	ListByStatus(ctx context.Context, statuses []entity.OrderStatus, after *entity.OrderCursor, limit int32, view entity.OrderView) ([]entity.Order, *entity.OrderCursor, error)
+	ListByPlace(ctx context.Context, placeID string, statuses []entity.OrderStatus) ([]entity.Order, error)
+	Search(ctx context.Context, filter entity.OrderFilter, sort entity.OrderSort, limit, offset int32, view entity.OrderView) ([]entity.Order, int64, error)
+	CountByPickupTime(ctx context.Context, placeID string, from, to time.Time) (map[int64]int32, error)
+	CountInPickupRange(ctx context.Context, placeID string, from, to time.Time) (int32, error)
+	AddStatusChange(ctx context.Context, change *entity.OrderStatusChange) error
+	ListStatusChanges(ctx context.Context, orderID string) ([]entity.OrderStatusChange, error)
 }
 
 type (
@@ -61,8 +104,8 @@ func New(transactor txManager) *repository {
 func (r *repository) Create(ctx context.Context, order *entity.Order) error {
 	query := r.queryBuilder.
 		Insert(orderTable).
-		Columns(orderCustomerID, orderStatus, orderTotalAmount, orderPickUp).
-		Values(order.UserID, order.Status, order.TotalAmount, order.PickUp).
+		Columns(orderCustomerID, orderPlaceID, orderStatus, orderTotalAmount, orderCurrency, orderPickUp, orderPickupTime).
+		Values(order.UserID, order.RestaurantID, order.Status, order.TotalAmount.Amount, order.TotalAmount.Currency, order.PickUp, nullableTime(order.PickupTime)).
 		Suffix(fmt.Sprintf("RETURNING %s, %s, %s", orderID, orderCreatedAt, orderUpdatedAt))
 
 	sql, args, err := query.ToSql()
@@ -78,7 +121,7 @@ func (r *repository) Create(ctx context.Context, order *entity.Order) error {
 	var createdAt, updatedAt time.Time
 	err = conn.QueryRow(ctx, sql, args...).Scan(&order.ID, &createdAt, &updatedAt)
 	if err != nil {
-		return fmt.Errorf("insert order: %w", err)
+		return fmt.Errorf("insert order: %w", pgerrors.Translate(err))
 	}
 
 	order.CreatedAt = createdAt.Unix()
@@ -87,27 +130,32 @@ func (r *repository) Create(ctx context.Context, order *entity.Order) error {
 	return nil
 }
 
+// CreateItems inserts all items of an order with a single multi-row INSERT.
 func (r *repository) CreateItems(ctx context.Context, orderID string, items []entity.OrderItem) error {
-	conn, err := r.transactor.GetConn(ctx)
-	if err != nil {
-		return err
+	if len(items) == 0 {
+		return nil
 	}
 
+	query := r.queryBuilder.
+		Insert(orderItemTable).
+		Columns(orderItemOrderID, orderItemMenuItemID, orderItemQuantity, orderItemUnitPrice)
 	for _, item := range items {
-		itemQuery := r.queryBuilder.
-			Insert(orderItemTable).
-			Columns(orderItemOrderID, orderItemMenuItemID, orderItemQuantity, orderItemUnitPrice).
-			Values(orderID, item.MenuItemID, item.Quantity, item.UnitPrice)
+		query = query.Values(orderID, item.MenuItemID, item.Quantity, item.UnitPrice.Amount)
+	}
 
-		itemSql, itemArgs, err := itemQuery.ToSql()
-		if err != nil {
-			return fmt.Errorf("build create order item query: %w", err)
-		}
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("build create order items query: %w", err)
+	}
 
-		_, err = conn.Exec(ctx, itemSql, itemArgs...)
-		if err != nil {
-			return fmt.Errorf("insert order item: %w", err)
-		}
+	conn, err := r.transactor.GetConn(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("insert order items: %w", pgerrors.Translate(err))
 	}
 
 	return nil
@@ -115,7 +163,7 @@ func (r *repository) CreateItems(ctx context.Context, orderID string, items []en
 
 func (r *repository) Get(ctx context.Context, id string) (*entity.Order, error) {
 	query := r.queryBuilder.
-		Select(orderID, orderCustomerID, orderStatus, orderTotalAmount, orderPickUp, orderCreatedAt, orderUpdatedAt).
+		Select(orderColumns...).
 		From(orderTable).
 		Where(sq.Eq{orderID: id})
 
@@ -129,51 +177,32 @@ func (r *repository) Get(ctx context.Context, id string) (*entity.Order, error)
 		return nil, err
 	}
 
-	order := &entity.Order{}
-	var createdAt, updatedAt time.Time
-	err = conn.QueryRow(ctx, sql, args...).Scan(&order.ID, &order.UserID, &order.Status, &order.TotalAmount, &order.PickUp, &createdAt, &updatedAt)
+	orders := make([]entity.Order, 1)
+	err = scanOrder(conn.QueryRow(ctx, sql, args...), &orders[0])
 	if err != nil {
-		if err == pgx.ErrNoRows {
-			return nil, nil
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entity.NewNotFoundError("ORDER_NOT_FOUND", "order not found").
+				WithMetadata("order_id", id)
 		}
-		return nil, fmt.Errorf("scan order: %w", err)
-	}
-	order.CreatedAt = createdAt.Unix()
-	order.UpdatedAt = updatedAt.Unix()
-
-	itemsQuery := r.queryBuilder.
-		Select(orderItemMenuItemID, orderItemQuantity, orderItemUnitPrice).
-		From(orderItemTable).
-		Where(sq.Eq{orderItemOrderID: id})
-
-	itemsSql, itemsArgs, err := itemsQuery.ToSql()
-	if err != nil {
-		return nil, fmt.Errorf("build get order items query: %w", err)
-	}
-
-	rows, err := conn.Query(ctx, itemsSql, itemsArgs...)
-	if err != nil {
-		return nil, fmt.Errorf("query order items: %w", err)
+		return nil, fmt.Errorf("scan order: %w", pgerrors.Translate(err))
 	}
-	defer rows.Close()
 
-	for rows.Next() {
-		var item entity.OrderItem
-		if err := rows.Scan(&item.MenuItemID, &item.Quantity, &item.UnitPrice); err != nil {
-			return nil, fmt.Errorf("scan order item: %w", err)
-		}
-		order.Items = append(order.Items, item)
+	if err := r.loadItems(ctx, conn, orders); err != nil {
+		return nil, err
 	}
 
-	return order, nil
+	return &orders[0], nil
 }
 
-func (r *repository) UpdateStatus(ctx context.Context, id string, status entity.OrderStatus) error {
+// UpdateStatus moves the order to status to only if it is still in status
+// from, returning entity.ErrOrderStatusChanged otherwise.
+func (r *repository) UpdateStatus(ctx context.Context, id string, from, to entity.OrderStatus) error {
 	query := r.queryBuilder.
 		Update(orderTable).
-		Set(orderStatus, status).
-		Set(orderUpdatedAt, "NOW()").
-		Where(sq.Eq{orderID: id})
+		Set(orderStatus, to).
+		Set(orderUpdatedAt, sq.Expr("NOW()")).
+		Where(sq.Eq{orderID: id, orderStatus: from}).
+		Suffix("RETURNING " + placeIDColumn)
 
 	sql, args, err := query.ToSql()
 	if err != nil {
@@ -185,70 +214,253 @@ func (r *repository) UpdateStatus(ctx context.Context, id string, status entity.
 		return err
 	}
 
+	notification := statusNotification{OrderID: id}
+	err = conn.QueryRow(ctx, sql, args...).Scan(&notification.PlaceID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.ErrOrderStatusChanged
+		}
+		return fmt.Errorf("update order status: %w", pgerrors.Translate(err))
+	}
+
+	payload, err := json.Marshal(notification)
+	if err != nil {
+		return fmt.Errorf("marshal order status notification: %w", err)
+	}
+
+	// Notifications sent inside a transaction are delivered on commit, so
+	// listeners never see a change that is rolled back.
+	notify := r.queryBuilder.Select().Column(sq.Expr("pg_notify(?, ?)", statusChannel, string(payload)))
+
+	sql, args, err = notify.ToSql()
+	if err != nil {
+		return fmt.Errorf("build notify order status query: %w", err)
+	}
+
 	_, err = conn.Exec(ctx, sql, args...)
 	if err != nil {
-		return fmt.Errorf("update order status: %w", err)
+		return fmt.Errorf("notify order status: %w", pgerrors.Translate(err))
 	}
 
 	return nil
 }
 
-func (r *repository) ListByUser(ctx context.Context, userID string, limit, offset int32) ([]entity.Order, error) {
+// ListByUser returns up to limit orders of the user after the cursor, newest
+// first, and the cursor of the next page, which is nil on the last page.
+// Items are loaded only for entity.OrderViewFull.
+func (r *repository) ListByUser(ctx context.Context, userID string, after *entity.OrderCursor, limit int32, view entity.OrderView) ([]entity.Order, *entity.OrderCursor, error) {
 	query := r.queryBuilder.
-		Select(orderID, orderCustomerID, orderStatus, orderTotalAmount, orderPickUp, orderCreatedAt, orderUpdatedAt).
+		Select(orderColumns...).
 		From(orderTable).
-		Where(sq.Eq{orderCustomerID: userID}).
-		OrderBy(fmt.Sprintf("%s DESC", orderCreatedAt)).
-		Limit(uint64(limit)).
-		Offset(uint64(offset))
+		Where(sq.Eq{orderCustomerID: userID})
+
+	return r.listPage(ctx, query, after, limit, view)
+}
+
+// ListByStatus is like ListByUser, but for orders in any of the statuses.
+func (r *repository) ListByStatus(ctx context.Context, statuses []entity.OrderStatus, after *entity.OrderCursor, limit int32, view entity.OrderView) ([]entity.Order, *entity.OrderCursor, error) {
+	if len(statuses) == 0 {
+		return nil, nil, nil
+	}
+
+	query := r.queryBuilder.
+		Select(orderColumns...).
+		From(orderTable).
+		Where(sq.Eq{orderStatus: statuses})
+
+	return r.listPage(ctx, query, after, limit, view)
+}
+
+// listPage pages through the orders selected by query on the
+// (created_at, id) keyset. It reads one row more than limit to find out
+// whether there is a next page.
+func (r *repository) listPage(ctx context.Context, query sq.SelectBuilder, after *entity.OrderCursor, limit int32, view entity.OrderView) ([]entity.Order, *entity.OrderCursor, error) {
+	if after != nil {
+		query = query.Where(sq.Expr(
+			fmt.Sprintf("(%s, %s) < (?, ?)", orderCreatedAt, orderID),
+			after.CreatedAt, after.ID,
+		))
+	}
+	query = query.
+		OrderBy(orderCreatedAt+" DESC", orderID+" DESC").
+		Limit(uint64(limit) + 1)
 
 	sql, args, err := query.ToSql()
 	if err != nil {
-		return nil, fmt.Errorf("build list orders by user query: %w", err)
+		return nil, nil, fmt.Errorf("build list orders query: %w", err)
 	}
 
 	conn, err := r.transactor.GetConn(ctx)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("query orders: %w", pgerrors.Translate(err))
+	}
+	defer rows.Close()
+
+	var (
+		orders []entity.Order
+		next   *entity.OrderCursor
+		last   entity.OrderCursor
+	)
+	for rows.Next() {
+		if len(orders) == int(limit) {
+			next = &last
+			break
+		}
+		var order entity.Order
+		createdAt, err := scanOrderCreatedAt(rows, &order)
+		if err != nil {
+			return nil, nil, fmt.Errorf("scan order: %w", pgerrors.Translate(err))
+		}
+		orders = append(orders, order)
+		last = entity.OrderCursor{CreatedAt: createdAt, ID: order.ID}
+	}
+	// The extra row may still be unread; the connection has to be free
+	// before the items are queried.
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("iterate orders: %w", pgerrors.Translate(err))
+	}
+
+	if view == entity.OrderViewFull {
+		if err := r.loadItems(ctx, conn, orders); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return orders, next, nil
+}
+
+// orderSortColumns maps sort fields to the columns they sort by.
+var orderSortColumns = map[entity.OrderSortField]string{
+	entity.OrderSortByCreatedAt:   orderCreatedAt,
+	entity.OrderSortByTotalAmount: orderTotalAmount,
+	entity.OrderSortByPickupTime:  orderPickupTime,
+}
+
+// Search returns a page of the orders matching filter and the number of all
+// matching orders.
+func (r *repository) Search(ctx context.Context, filter entity.OrderFilter, sort entity.OrderSort, limit, offset int32, view entity.OrderView) ([]entity.Order, int64, error) {
+	conn, err := r.transactor.GetConn(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	countQuery := applyOrderFilter(r.queryBuilder.Select("COUNT(*)").From(orderTable), filter)
+
+	sql, args, err := countQuery.ToSql()
+	if err != nil {
+		return nil, 0, fmt.Errorf("build count orders query: %w", err)
+	}
+
+	var total int64
+	if err := conn.QueryRow(ctx, sql, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("count orders: %w", pgerrors.Translate(err))
+	}
+	if total == 0 {
+		return nil, 0, nil
+	}
+
+	direction := "DESC"
+	if sort.Ascending {
+		direction = "ASC"
+	}
+	sortColumn, ok := orderSortColumns[sort.Field]
+	if !ok {
+		sortColumn = orderCreatedAt
+	}
+
+	query := applyOrderFilter(r.queryBuilder.Select(orderColumns...).From(orderTable), filter).
+		OrderBy(
+			fmt.Sprintf("%s %s NULLS LAST", sortColumn, direction),
+			fmt.Sprintf("%s %s", orderID, direction),
+		).
+		Limit(uint64(limit)).
+		Offset(uint64(offset))
+
+	sql, args, err = query.ToSql()
+	if err != nil {
+		return nil, 0, fmt.Errorf("build search orders query: %w", err)
 	}
 
 	rows, err := conn.Query(ctx, sql, args...)
 	if err != nil {
-		return nil, fmt.Errorf("query orders by user: %w", err)
+		return nil, 0, fmt.Errorf("query orders: %w", pgerrors.Translate(err))
 	}
 	defer rows.Close()
 
 	var orders []entity.Order
 	for rows.Next() {
 		var order entity.Order
-		var createdAt, updatedAt time.Time
-		if err := rows.Scan(&order.ID, &order.UserID, &order.Status, &order.TotalAmount, &order.PickUp, &createdAt, &updatedAt); err != nil {
-			return nil, fmt.Errorf("scan order: %w", err)
+		if err := scanOrder(rows, &order); err != nil {
+			return nil, 0, fmt.Errorf("scan order: %w", pgerrors.Translate(err))
 		}
-		order.CreatedAt = createdAt.Unix()
-		order.UpdatedAt = updatedAt.Unix()
 		orders = append(orders, order)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("iterate orders: %w", pgerrors.Translate(err))
+	}
 
-	return orders, nil
+	if view == entity.OrderViewFull {
+		if err := r.loadItems(ctx, conn, orders); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return orders, total, nil
 }
 
-func (r *repository) ListByStatus(ctx context.Context, statuses []entity.OrderStatus, limit, offset int32) ([]entity.Order, error) {
+func applyOrderFilter(query sq.SelectBuilder, filter entity.OrderFilter) sq.SelectBuilder {
+	if filter.CustomerPhone != "" {
+		query = query.Where(sq.Expr(
+			fmt.Sprintf("%s IN (SELECT %s FROM %s WHERE %s = ?)", orderCustomerID, customerID, customerTable, customerPhone),
+			filter.CustomerPhone,
+		))
+	}
+	if filter.PlaceID != "" {
+		query = query.Where(sq.Eq{orderPlaceID: filter.PlaceID})
+	}
+	if len(filter.Statuses) > 0 {
+		query = query.Where(sq.Eq{orderStatus: filter.Statuses})
+	}
+	if !filter.CreatedFrom.IsZero() {
+		query = query.Where(sq.GtOrEq{orderCreatedAt: filter.CreatedFrom})
+	}
+	if !filter.CreatedTo.IsZero() {
+		query = query.Where(sq.Lt{orderCreatedAt: filter.CreatedTo})
+	}
+	if filter.MinTotalAmount != nil {
+		query = query.Where(sq.GtOrEq{orderTotalAmount: *filter.MinTotalAmount})
+	}
+	if filter.MaxTotalAmount != nil {
+		query = query.Where(sq.LtOrEq{orderTotalAmount: *filter.MaxTotalAmount})
+	}
+	if filter.PickUp != nil {
+		query = query.Where(sq.Eq{orderPickUp: *filter.PickUp})
+	}
+	return query
+}
+
+// ListByPlace returns the orders of a place in the given statuses together
+// with their items, oldest first.
+func (r *repository) ListByPlace(ctx context.Context, placeID string, statuses []entity.OrderStatus) ([]entity.Order, error) {
 	if len(statuses) == 0 {
 		return nil, nil
 	}
 
 	query := r.queryBuilder.
-		Select(orderID, orderCustomerID, orderStatus, orderTotalAmount, orderPickUp, orderCreatedAt, orderUpdatedAt).
+		Select(orderColumns...).
 		From(orderTable).
-		Where(sq.Eq{orderStatus: statuses}).
-		OrderBy(fmt.Sprintf("%s DESC", orderCreatedAt)).
-		Limit(uint64(limit)).
-		Offset(uint64(offset))
+		Where(sq.Eq{orderPlaceID: placeID, orderStatus: statuses}).
+		OrderBy(orderCreatedAt, orderID)
 
 	sql, args, err := query.ToSql()
 	if err != nil {
-		return nil, fmt.Errorf("build list orders by status query: %w", err)
+		return nil, fmt.Errorf("build list orders by place query: %w", err)
 	}
 
 	conn, err := r.transactor.GetConn(ctx)
@@ -258,21 +470,262 @@ func (r *repository) ListByStatus(ctx context.Context, statuses []entity.OrderSt
 
 	rows, err := conn.Query(ctx, sql, args...)
 	if err != nil {
-		return nil, fmt.Errorf("query orders by status: %w", err)
+		return nil, fmt.Errorf("query orders by place: %w", pgerrors.Translate(err))
 	}
 	defer rows.Close()
 
 	var orders []entity.Order
 	for rows.Next() {
 		var order entity.Order
-		var createdAt, updatedAt time.Time
-		if err := rows.Scan(&order.ID, &order.UserID, &order.Status, &order.TotalAmount, &order.PickUp, &createdAt, &updatedAt); err != nil {
-			return nil, fmt.Errorf("scan order: %w", err)
+		if err := scanOrder(rows, &order); err != nil {
+			return nil, fmt.Errorf("scan order: %w", pgerrors.Translate(err))
 		}
-		order.CreatedAt = createdAt.Unix()
-		order.UpdatedAt = updatedAt.Unix()
 		orders = append(orders, order)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate orders: %w", pgerrors.Translate(err))
+	}
+
+	if err := r.loadItems(ctx, conn, orders); err != nil {
+		return nil, err
+	}
 
 	return orders, nil
 }
+
+// loadItems fills in the items of orders with a single query, however many
+// orders there are.
+func (r *repository) loadItems(ctx context.Context, conn postgres.Conn, orders []entity.Order) error {
+	if len(orders) == 0 {
+		return nil
+	}
+
+	positions := make(map[string]int, len(orders))
+	ids := make([]string, len(orders))
+	for i, order := range orders {
+		positions[order.ID] = i
+		ids[i] = order.ID
+	}
+
+	query := r.queryBuilder.
+		Select(orderItemOrderID, orderItemMenuItemID, orderItemQuantity, orderItemUnitPrice).
+		From(orderItemTable).
+		Where(sq.Expr(fmt.Sprintf("%s = ANY(?)", orderItemOrderID), ids))
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("build list order items query: %w", err)
+	}
+
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("query order items: %w", pgerrors.Translate(err))
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			orderID string
+			item    entity.OrderItem
+		)
+		if err := rows.Scan(&orderID, &item.MenuItemID, &item.Quantity, &item.UnitPrice.Amount); err != nil {
+			return fmt.Errorf("scan order item: %w", pgerrors.Translate(err))
+		}
+		order := &orders[positions[orderID]]
+		// Items are always priced in the currency of their order.
+		item.UnitPrice.Currency = order.TotalAmount.Currency
+		order.Items = append(order.Items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("iterate order items: %w", pgerrors.Translate(err))
+	}
+
+	return nil
+}
+
+func (r *repository) AddStatusChange(ctx context.Context, change *entity.OrderStatusChange) error {
+	query := r.queryBuilder.
+		Insert(historyTable).
+		Columns(historyOrderID, historyFromStatus, historyToStatus, historyReason, historyChangedBy).
+		Values(change.OrderID, change.From, change.To, change.Reason, change.ChangedBy).
+		Suffix(fmt.Sprintf("RETURNING %s", historyCreatedAt))
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("build create order status change query: %w", err)
+	}
+
+	conn, err := r.transactor.GetConn(ctx)
+	if err != nil {
+		return err
+	}
+
+	var changedAt time.Time
+	err = conn.QueryRow(ctx, sql, args...).Scan(&changedAt)
+	if err != nil {
+		return fmt.Errorf("insert order status change: %w", pgerrors.Translate(err))
+	}
+
+	change.ChangedAt = changedAt.Unix()
+
+	return nil
+}
+
+func (r *repository) ListStatusChanges(ctx context.Context, orderID string) ([]entity.OrderStatusChange, error) {
+	query := r.queryBuilder.
+		Select(historyOrderID, historyFromStatus, historyToStatus, historyReason, historyChangedBy, historyCreatedAt).
+		From(historyTable).
+		Where(sq.Eq{historyOrderID: orderID}).
+		// Changes made in one transaction share created_at; the ID keeps
+		// their order stable between calls.
+		OrderBy(historyCreatedAt, historyID)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build list order status changes query: %w", err)
+	}
+
+	conn, err := r.transactor.GetConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query order status changes: %w", pgerrors.Translate(err))
+	}
+	defer rows.Close()
+
+	var changes []entity.OrderStatusChange
+	for rows.Next() {
+		var change entity.OrderStatusChange
+		var changedAt time.Time
+		if err := rows.Scan(&change.OrderID, &change.From, &change.To, &change.Reason, &change.ChangedBy, &changedAt); err != nil {
+			return nil, fmt.Errorf("scan order status change: %w", pgerrors.Translate(err))
+		}
+		change.ChangedAt = changedAt.Unix()
+		changes = append(changes, change)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate order status changes: %w", pgerrors.Translate(err))
+	}
+
+	return changes, nil
+}
+
+// CountByPickupTime counts the orders of a place scheduled for pickup in
+// [from, to), keyed by the Unix time of their pickup. Cancelled and failed
+// orders do not take up capacity.
+func (r *repository) CountByPickupTime(ctx context.Context, placeID string, from, to time.Time) (map[int64]int32, error) {
+	query := r.queryBuilder.
+		Select(orderPickupTime, "COUNT(*)").
+		From(orderTable).
+		Where(sq.Eq{orderPlaceID: placeID}).
+		Where(sq.GtOrEq{orderPickupTime: from}).
+		Where(sq.Lt{orderPickupTime: to}).
+		Where(sq.NotEq{orderStatus: []entity.OrderStatus{entity.OrderStatusCancelled, entity.OrderStatusFailed}}).
+		GroupBy(orderPickupTime)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build count orders by pickup time query: %w", err)
+	}
+
+	conn, err := r.transactor.GetConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query orders by pickup time: %w", pgerrors.Translate(err))
+	}
+	defer rows.Close()
+
+	counts := make(map[int64]int32)
+	for rows.Next() {
+		var pickupTime time.Time
+		var count int32
+		if err := rows.Scan(&pickupTime, &count); err != nil {
+			return nil, fmt.Errorf("scan order count: %w", pgerrors.Translate(err))
+		}
+		counts[pickupTime.Unix()] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate order counts: %w", pgerrors.Translate(err))
+	}
+
+	return counts, nil
+}
+
+// CountInPickupRange counts the orders of a place scheduled for pickup in
+// [from, to), whatever their exact pickup time. Cancelled and failed orders
+// do not take up capacity.
+func (r *repository) CountInPickupRange(ctx context.Context, placeID string, from, to time.Time) (int32, error) {
+	query := r.queryBuilder.
+		Select("COUNT(*)").
+		From(orderTable).
+		Where(sq.Eq{orderPlaceID: placeID}).
+		Where(sq.GtOrEq{orderPickupTime: from}).
+		Where(sq.Lt{orderPickupTime: to}).
+		Where(sq.NotEq{orderStatus: []entity.OrderStatus{entity.OrderStatusCancelled, entity.OrderStatusFailed}})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("build count orders in pickup range query: %w", err)
+	}
+
+	conn, err := r.transactor.GetConn(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int32
+	if err := conn.QueryRow(ctx, sql, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("count orders in pickup range: %w", pgerrors.Translate(err))
+	}
+
+	return count, nil
+}
+
+func scanOrder(row pgx.Row, order *entity.Order) error {
+	_, err := scanOrderCreatedAt(row, order)
+	return err
+}
+
+// scanOrderCreatedAt is scanOrder that also returns the exact creation time,
+// which order.CreatedAt keeps only to the second.
+func scanOrderCreatedAt(row pgx.Row, order *entity.Order) (time.Time, error) {
+	var pickupTime *time.Time
+	var createdAt, updatedAt time.Time
+	err := row.Scan(
+		&order.ID,
+		&order.UserID,
+		&order.RestaurantID,
+		&order.Status,
+		&order.TotalAmount.Amount,
+		&order.TotalAmount.Currency,
+		&order.PickUp,
+		&pickupTime,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if pickupTime != nil {
+		order.PickupTime = *pickupTime
+	}
+	order.CreatedAt = createdAt.Unix()
+	order.UpdatedAt = updatedAt.Unix()
+
+	return createdAt, nil
+}
+
+func nullableTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
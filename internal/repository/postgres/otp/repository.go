@@ -0,0 +1,196 @@
+package otp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/Tortik3000/service-order/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Tortik3000/service-order/internal/domain/entity"
+	"github.com/Tortik3000/service-order/internal/repository/pgerrors"
+)
+
+const (
+	codeTable       = "otp_code"
+	codePhone       = "phone"
+	codeHash        = "code_hash"
+	codeAttempts    = "attempts"
+	codeExpiresAt   = "expires_at"
+	codeCreatedAt   = "created_at"
+	codeLockedUntil = "locked_until"
+)
+
+type Repository interface {
+	Save(ctx context.Context, code *entity.OtpCode) error
+	GetForUpdate(ctx context.Context, phone string) (*entity.OtpCode, error)
+	IncrementAttempts(ctx context.Context, phone string) error
+	Lock(ctx context.Context, phone string, until time.Time) error
+	Delete(ctx context.Context, phone string) error
+}
+
+type (
+	txManager interface {
+		GetConn(ctx context.Context) (postgres.Conn, error)
+	}
+)
+
+type repository struct {
+	transactor   txManager
+	queryBuilder sq.StatementBuilderType
+}
+
+var _ Repository = (*repository)(nil)
+
+func New(transactor txManager) *repository {
+	return &repository{
+		transactor:   transactor,
+		queryBuilder: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+// Save stores the code for its phone, replacing a previous code together
+// with its attempts and lockout.
+func (r *repository) Save(ctx context.Context, code *entity.OtpCode) error {
+	query := r.queryBuilder.
+		Insert(codeTable).
+		Columns(codePhone, codeHash, codeExpiresAt).
+		Values(code.Phone, code.CodeHash, code.ExpiresAt).
+		Suffix(fmt.Sprintf(
+			`ON CONFLICT (%[1]s) DO UPDATE
+			SET %[2]s = EXCLUDED.%[2]s, %[3]s = 0, %[4]s = EXCLUDED.%[4]s, %[5]s = NOW(), %[6]s = NULL
+			RETURNING %[5]s`,
+			codePhone, codeHash, codeAttempts, codeExpiresAt, codeCreatedAt, codeLockedUntil,
+		))
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("build save otp code query: %w", err)
+	}
+
+	conn, err := r.transactor.GetConn(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = conn.QueryRow(ctx, sql, args...).Scan(&code.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("insert otp code: %w", pgerrors.Translate(err))
+	}
+	code.Attempts = 0
+	code.LockedUntil = time.Time{}
+
+	return nil
+}
+
+// GetForUpdate returns the code of the phone and locks it until the end of
+// the current transaction, so that concurrent attempts are counted.
+func (r *repository) GetForUpdate(ctx context.Context, phone string) (*entity.OtpCode, error) {
+	query := r.queryBuilder.
+		Select(codePhone, codeHash, codeAttempts, codeExpiresAt, codeCreatedAt, codeLockedUntil).
+		From(codeTable).
+		Where(sq.Eq{codePhone: phone}).
+		Suffix("FOR UPDATE")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build get otp code query: %w", err)
+	}
+
+	conn, err := r.transactor.GetConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	code := &entity.OtpCode{}
+	var lockedUntil *time.Time
+	err = conn.QueryRow(ctx, sql, args...).Scan(&code.Phone, &code.CodeHash, &code.Attempts, &code.ExpiresAt, &code.CreatedAt, &lockedUntil)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entity.NewNotFoundError("OTP_CODE_NOT_FOUND", "otp code not found")
+		}
+		return nil, fmt.Errorf("scan otp code: %w", pgerrors.Translate(err))
+	}
+	if lockedUntil != nil {
+		code.LockedUntil = *lockedUntil
+	}
+
+	return code, nil
+}
+
+func (r *repository) IncrementAttempts(ctx context.Context, phone string) error {
+	query := r.queryBuilder.
+		Update(codeTable).
+		Set(codeAttempts, sq.Expr(codeAttempts+" + 1")).
+		Where(sq.Eq{codePhone: phone})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("build increment otp attempts query: %w", err)
+	}
+
+	conn, err := r.transactor.GetConn(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("update otp code: %w", pgerrors.Translate(err))
+	}
+
+	return nil
+}
+
+// Lock expires the code of the phone and records that no new code may be
+// sent to it before until.
+func (r *repository) Lock(ctx context.Context, phone string, until time.Time) error {
+	query := r.queryBuilder.
+		Update(codeTable).
+		Set(codeExpiresAt, sq.Expr("NOW()")).
+		Set(codeLockedUntil, until).
+		Where(sq.Eq{codePhone: phone})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("build lock otp code query: %w", err)
+	}
+
+	conn, err := r.transactor.GetConn(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("update otp code: %w", pgerrors.Translate(err))
+	}
+
+	return nil
+}
+
+func (r *repository) Delete(ctx context.Context, phone string) error {
+	query := r.queryBuilder.
+		Delete(codeTable).
+		Where(sq.Eq{codePhone: phone})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("build delete otp code query: %w", err)
+	}
+
+	conn, err := r.transactor.GetConn(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("delete otp code: %w", pgerrors.Translate(err))
+	}
+
+	return nil
+}
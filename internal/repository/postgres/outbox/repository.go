@@ -0,0 +1,258 @@
+package outbox
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/Tortik3000/service-order/pkg/postgres"
+
+	"github.com/Tortik3000/service-order/internal/domain/entity"
+	"github.com/Tortik3000/service-order/internal/repository/pgerrors"
+)
+
+const (
+	outboxTable         = "outbox"
+	outboxID            = "id"
+	outboxTopic         = "topic"
+	outboxKey           = "key"
+	outboxEventType     = "event_type"
+	outboxPayload       = "payload"
+	outboxAttempts      = "attempts"
+	outboxLastError     = "last_error"
+	outboxCreatedAt     = "created_at"
+	outboxNextAttemptAt = "next_attempt_at"
+	outboxPublishedAt   = "published_at"
+)
+
+type Repository interface {
+	Add(ctx context.Context, msg *entity.OutboxMessage) error
+	ClaimPending(ctx context.Context, limit int32, lease time.Duration) ([]entity.OutboxMessage, error)
+	MarkPublished(ctx context.Context, ids []int64) error
+	MarkFailed(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string) error
+	OldestPending(ctx context.Context) (time.Time, error)
+	DeletePublished(ctx context.Context, before time.Time, limit int32) (int64, error)
+}
+
+type (
+	txManager interface {
+		GetConn(ctx context.Context) (postgres.Conn, error)
+	}
+)
+
+type repository struct {
+	transactor   txManager
+	queryBuilder sq.StatementBuilderType
+}
+
+var _ Repository = (*repository)(nil)
+
+func New(transactor txManager) *repository {
+	return &repository{
+		transactor:   transactor,
+		queryBuilder: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+func (r *repository) Add(ctx context.Context, msg *entity.OutboxMessage) error {
+	query := r.queryBuilder.
+		Insert(outboxTable).
+		Columns(outboxTopic, outboxKey, outboxEventType, outboxPayload).
+		Values(msg.Topic, msg.Key, msg.EventType, msg.Payload).
+		Suffix(fmt.Sprintf("RETURNING %s, %s", outboxID, outboxCreatedAt))
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("build insert outbox message query: %w", err)
+	}
+
+	conn, err := r.transactor.GetConn(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = conn.QueryRow(ctx, sql, args...).Scan(&msg.ID, &msg.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("insert outbox message: %w", pgerrors.Translate(err))
+	}
+
+	return nil
+}
+
+// ClaimPending returns up to limit unpublished messages that are due, oldest
+// first, and postpones their next attempt by lease, so that the caller can
+// publish them outside of a transaction while other relays skip them. A
+// message is only returned once every older message with the same key is
+// published, which keeps the events of an order in order. If the caller
+// neither marks a message published nor failed, it is claimed again after
+// the lease.
+func (r *repository) ClaimPending(ctx context.Context, limit int32, lease time.Duration) ([]entity.OutboxMessage, error) {
+	due := r.queryBuilder.
+		Select("o." + outboxID).
+		From(outboxTable + " o").
+		Where(sq.Eq{"o." + outboxPublishedAt: nil}).
+		Where(fmt.Sprintf("o.%s <= NOW()", outboxNextAttemptAt)).
+		Where(fmt.Sprintf(
+			"NOT EXISTS (SELECT 1 FROM %[1]s p WHERE p.%[2]s = o.%[2]s AND p.%[3]s IS NULL AND p.%[4]s < o.%[4]s)",
+			outboxTable, outboxKey, outboxPublishedAt, outboxID,
+		)).
+		OrderBy("o." + outboxID).
+		Limit(uint64(limit)).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	query := r.queryBuilder.
+		Update(outboxTable).
+		Set(outboxNextAttemptAt, sq.Expr("NOW() + make_interval(secs => ?)", lease.Seconds())).
+		Where(due.Prefix(outboxID + " IN (").Suffix(")")).
+		Suffix(fmt.Sprintf("RETURNING %s, %s, %s, %s, %s, %s, %s",
+			outboxID, outboxTopic, outboxKey, outboxEventType, outboxPayload, outboxAttempts, outboxCreatedAt))
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build claim pending outbox messages query: %w", err)
+	}
+
+	conn, err := r.transactor.GetConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("claim pending outbox messages: %w", pgerrors.Translate(err))
+	}
+	defer rows.Close()
+
+	var res []entity.OutboxMessage
+	for rows.Next() {
+		var msg entity.OutboxMessage
+		err := rows.Scan(&msg.ID, &msg.Topic, &msg.Key, &msg.EventType, &msg.Payload, &msg.Attempts, &msg.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("scan outbox message: %w", pgerrors.Translate(err))
+		}
+		res = append(res, msg)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate outbox messages: %w", pgerrors.Translate(err))
+	}
+
+	// RETURNING does not keep the order of the subquery.
+	slices.SortFunc(res, func(a, b entity.OutboxMessage) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return res, nil
+}
+
+func (r *repository) MarkPublished(ctx context.Context, ids []int64) error {
+	query := r.queryBuilder.
+		Update(outboxTable).
+		Set(outboxPublishedAt, sq.Expr("NOW()")).
+		Set(outboxLastError, "").
+		Where(sq.Expr(fmt.Sprintf("%s = ANY(?)", outboxID), ids))
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("build mark outbox messages published query: %w", err)
+	}
+
+	conn, err := r.transactor.GetConn(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("update outbox messages: %w", pgerrors.Translate(err))
+	}
+
+	return nil
+}
+
+func (r *repository) MarkFailed(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string) error {
+	query := r.queryBuilder.
+		Update(outboxTable).
+		Set(outboxAttempts, sq.Expr(fmt.Sprintf("%s + 1", outboxAttempts))).
+		Set(outboxNextAttemptAt, nextAttemptAt).
+		Set(outboxLastError, lastError).
+		Where(sq.Eq{outboxID: id})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("build mark outbox message failed query: %w", err)
+	}
+
+	conn, err := r.transactor.GetConn(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("update outbox message: %w", pgerrors.Translate(err))
+	}
+
+	return nil
+}
+
+// OldestPending returns when the oldest unpublished message was added, or
+// the zero time if every message is published.
+func (r *repository) OldestPending(ctx context.Context) (time.Time, error) {
+	query := r.queryBuilder.
+		Select(fmt.Sprintf("MIN(%s)", outboxCreatedAt)).
+		From(outboxTable).
+		Where(sq.Eq{outboxPublishedAt: nil})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("build oldest pending outbox message query: %w", err)
+	}
+
+	conn, err := r.transactor.GetConn(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var oldest *time.Time
+	if err := conn.QueryRow(ctx, sql, args...).Scan(&oldest); err != nil {
+		return time.Time{}, fmt.Errorf("query oldest pending outbox message: %w", pgerrors.Translate(err))
+	}
+	if oldest == nil {
+		return time.Time{}, nil
+	}
+	return *oldest, nil
+}
+
+// DeletePublished deletes up to limit messages published before the given
+// time and returns how many it deleted.
+func (r *repository) DeletePublished(ctx context.Context, before time.Time, limit int32) (int64, error) {
+	batch := r.queryBuilder.
+		Select(outboxID).
+		From(outboxTable).
+		Where(sq.Lt{outboxPublishedAt: before}).
+		Limit(uint64(limit))
+
+	query := r.queryBuilder.
+		Delete(outboxTable).
+		Where(batch.Prefix(outboxID + " IN (").Suffix(")"))
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("build delete published outbox messages query: %w", err)
+	}
+
+	conn, err := r.transactor.GetConn(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	tag, err := conn.Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, fmt.Errorf("delete published outbox messages: %w", pgerrors.Translate(err))
+	}
+
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,305 @@
+package place
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/Tortik3000/service-order/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Tortik3000/service-order/internal/domain/entity"
+	"github.com/Tortik3000/service-order/internal/repository/pgerrors"
+)
+
+const (
+	placeTable              = "place"
+	placeID                 = "id"
+	placeName               = "name"
+	placeAddress            = "address"
+	placeLatitude           = "latitude"
+	placeLongitude          = "longitude"
+	placeActive             = "active"
+	placeTimezone           = "timezone"
+	placePickupSlotMinutes  = "pickup_slot_minutes"
+	placePickupSlotCapacity = "pickup_slot_capacity"
+
+	hoursTable     = "place_opening_hours"
+	hoursPlaceID   = "place_id"
+	hoursDayOfWeek = "day_of_week"
+	hoursOpensAt   = "opens_at"
+	hoursClosesAt  = "closes_at"
+)
+
+type Repository interface {
+	Create(ctx context.Context, place *entity.Place) error
+	Get(ctx context.Context, id string) (*entity.Place, error)
+	GetForUpdate(ctx context.Context, id string) (*entity.Place, error)
+	List(ctx context.Context, activeOnly bool) ([]entity.Place, error)
+	Update(ctx context.Context, place *entity.Place) error
+}
+
+type (
+	txManager interface {
+		GetConn(ctx context.Context) (postgres.Conn, error)
+	}
+)
+
+type repository struct {
+	transactor   txManager
+	queryBuilder sq.StatementBuilderType
+}
+
+var _ Repository = (*repository)(nil)
+
+func New(transactor txManager) *repository {
+	return &repository{
+		transactor:   transactor,
+		queryBuilder: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+func (r *repository) Create(ctx context.Context, place *entity.Place) error {
+	query := r.queryBuilder.
+		Insert(placeTable).
+		Columns(placeName, placeAddress, placeLatitude, placeLongitude, placeActive, placeTimezone, placePickupSlotMinutes, placePickupSlotCapacity).
+		Values(place.Name, place.Address, place.Latitude, place.Longitude, place.Active, place.Timezone, place.PickupSlotMinutes, place.PickupSlotCapacity).
+		Suffix(fmt.Sprintf("RETURNING %s", placeID))
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("build create place query: %w", err)
+	}
+
+	conn, err := r.transactor.GetConn(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = conn.QueryRow(ctx, sql, args...).Scan(&place.ID)
+	if err != nil {
+		return fmt.Errorf("insert place: %w", pgerrors.Translate(err))
+	}
+
+	return r.insertOpeningHours(ctx, conn, place.ID, place.OpeningHours)
+}
+
+func (r *repository) Get(ctx context.Context, id string) (*entity.Place, error) {
+	return r.get(ctx, id, false)
+}
+
+// GetForUpdate is like Get but locks the place row until the end of the
+// current transaction.
+func (r *repository) GetForUpdate(ctx context.Context, id string) (*entity.Place, error) {
+	return r.get(ctx, id, true)
+}
+
+func (r *repository) get(ctx context.Context, id string, forUpdate bool) (*entity.Place, error) {
+	query := r.queryBuilder.
+		Select(placeID, placeName, placeAddress, placeLatitude, placeLongitude, placeActive, placeTimezone, placePickupSlotMinutes, placePickupSlotCapacity).
+		From(placeTable).
+		Where(sq.Eq{placeID: id})
+	if forUpdate {
+		query = query.Suffix("FOR UPDATE")
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build get place query: %w", err)
+	}
+
+	conn, err := r.transactor.GetConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	place := &entity.Place{}
+	err = conn.QueryRow(ctx, sql, args...).Scan(&place.ID, &place.Name, &place.Address, &place.Latitude, &place.Longitude, &place.Active, &place.Timezone, &place.PickupSlotMinutes, &place.PickupSlotCapacity)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entity.NewNotFoundError("PLACE_NOT_FOUND", "place not found").
+				WithMetadata("place_id", id)
+		}
+		return nil, fmt.Errorf("scan place: %w", pgerrors.Translate(err))
+	}
+
+	hours, err := r.listOpeningHours(ctx, conn, []string{place.ID})
+	if err != nil {
+		return nil, err
+	}
+	place.OpeningHours = hours[place.ID]
+
+	return place, nil
+}
+
+func (r *repository) List(ctx context.Context, activeOnly bool) ([]entity.Place, error) {
+	query := r.queryBuilder.
+		Select(placeID, placeName, placeAddress, placeLatitude, placeLongitude, placeActive, placeTimezone, placePickupSlotMinutes, placePickupSlotCapacity).
+		From(placeTable).
+		OrderBy(placeName)
+	if activeOnly {
+		query = query.Where(sq.Eq{placeActive: true})
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build list places query: %w", err)
+	}
+
+	conn, err := r.transactor.GetConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query places: %w", pgerrors.Translate(err))
+	}
+	defer rows.Close()
+
+	var places []entity.Place
+	var ids []string
+	for rows.Next() {
+		var place entity.Place
+		if err := rows.Scan(&place.ID, &place.Name, &place.Address, &place.Latitude, &place.Longitude, &place.Active, &place.Timezone, &place.PickupSlotMinutes, &place.PickupSlotCapacity); err != nil {
+			return nil, fmt.Errorf("scan place: %w", pgerrors.Translate(err))
+		}
+		places = append(places, place)
+		ids = append(ids, place.ID)
+	}
+	// The connection has to be free before the opening hours are queried.
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate places: %w", pgerrors.Translate(err))
+	}
+
+	if len(places) == 0 {
+		return places, nil
+	}
+
+	hours, err := r.listOpeningHours(ctx, conn, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range places {
+		places[i].OpeningHours = hours[places[i].ID]
+	}
+
+	return places, nil
+}
+
+// Update overwrites the place and replaces its opening hours, so it has to
+// run inside a transaction.
+func (r *repository) Update(ctx context.Context, place *entity.Place) error {
+	query := r.queryBuilder.
+		Update(placeTable).
+		Set(placeName, place.Name).
+		Set(placeAddress, place.Address).
+		Set(placeLatitude, place.Latitude).
+		Set(placeLongitude, place.Longitude).
+		Set(placeActive, place.Active).
+		Set(placeTimezone, place.Timezone).
+		Set(placePickupSlotMinutes, place.PickupSlotMinutes).
+		Set(placePickupSlotCapacity, place.PickupSlotCapacity).
+		Where(sq.Eq{placeID: place.ID})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("build update place query: %w", err)
+	}
+
+	conn, err := r.transactor.GetConn(ctx)
+	if err != nil {
+		return err
+	}
+
+	tag, err := conn.Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("update place: %w", pgerrors.Translate(err))
+	}
+	if tag.RowsAffected() == 0 {
+		return entity.NewNotFoundError("PLACE_NOT_FOUND", "place not found").
+			WithMetadata("place_id", place.ID)
+	}
+
+	deleteQuery := r.queryBuilder.
+		Delete(hoursTable).
+		Where(sq.Eq{hoursPlaceID: place.ID})
+
+	deleteSql, deleteArgs, err := deleteQuery.ToSql()
+	if err != nil {
+		return fmt.Errorf("build delete opening hours query: %w", err)
+	}
+
+	_, err = conn.Exec(ctx, deleteSql, deleteArgs...)
+	if err != nil {
+		return fmt.Errorf("delete opening hours: %w", pgerrors.Translate(err))
+	}
+
+	return r.insertOpeningHours(ctx, conn, place.ID, place.OpeningHours)
+}
+
+func (r *repository) insertOpeningHours(ctx context.Context, conn postgres.Conn, id string, hours []entity.OpeningHours) error {
+	if len(hours) == 0 {
+		return nil
+	}
+
+	query := r.queryBuilder.
+		Insert(hoursTable).
+		Columns(hoursPlaceID, hoursDayOfWeek, hoursOpensAt, hoursClosesAt)
+	for _, h := range hours {
+		query = query.Values(id, h.DayOfWeek, h.OpensAt, h.ClosesAt)
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("build create opening hours query: %w", err)
+	}
+
+	_, err = conn.Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("insert opening hours: %w", pgerrors.Translate(err))
+	}
+
+	return nil
+}
+
+func (r *repository) listOpeningHours(ctx context.Context, conn postgres.Conn, ids []string) (map[string][]entity.OpeningHours, error) {
+	query := r.queryBuilder.
+		Select(
+			hoursPlaceID,
+			hoursDayOfWeek,
+			fmt.Sprintf("to_char(%s, 'HH24:MI')", hoursOpensAt),
+			fmt.Sprintf("to_char(%s, 'HH24:MI')", hoursClosesAt),
+		).
+		From(hoursTable).
+		Where(sq.Eq{hoursPlaceID: ids}).
+		OrderBy(hoursPlaceID, hoursDayOfWeek)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build list opening hours query: %w", err)
+	}
+
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query opening hours: %w", pgerrors.Translate(err))
+	}
+	defer rows.Close()
+
+	hours := make(map[string][]entity.OpeningHours, len(ids))
+	for rows.Next() {
+		var id string
+		var h entity.OpeningHours
+		if err := rows.Scan(&id, &h.DayOfWeek, &h.OpensAt, &h.ClosesAt); err != nil {
+			return nil, fmt.Errorf("scan opening hours: %w", pgerrors.Translate(err))
+		}
+		hours[id] = append(hours[id], h)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate opening hours: %w", pgerrors.Translate(err))
+	}
+
+	return hours, nil
+}
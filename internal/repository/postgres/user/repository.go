@@ -2,25 +2,35 @@ package user
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/Tortik3000/service-order/pkg/postgres"
 	"github.com/jackc/pgx/v5"
 
 	"github.com/Tortik3000/service-order/internal/domain/entity"
+	"github.com/Tortik3000/service-order/internal/repository/pgerrors"
 )
 
 const (
-	userTable = "customer"
-	userID    = "id"
-	userPhone = "phone"
-	userName  = "name"
+	userTable            = "customer"
+	userID               = "id"
+	userPhone            = "phone"
+	userName             = "name"
+	userEmail            = "email"
+	userMarketingConsent = "marketing_consent"
+	userRole             = "role"
 )
 
+var userColumns = []string{userID, userPhone, userName, userEmail, userMarketingConsent, userRole}
+
 type Repository interface {
-	Create(ctx context.Context, user *entity.User) error
+	GetOrCreate(ctx context.Context, user *entity.User) error
+	Get(ctx context.Context, id string) (*entity.User, error)
 	GetByPhone(ctx context.Context, phone string) (*entity.User, error)
+	Update(ctx context.Context, user *entity.User) error
 }
 
 type (
@@ -43,12 +53,17 @@ func New(transactor txManager) *repository {
 	}
 }
 
-func (r *repository) Create(ctx context.Context, user *entity.User) error {
+// GetOrCreate inserts the user unless a user with the same phone already
+// exists, and fills user with the stored row either way. The unique index on
+// the phone makes this safe against concurrent registrations.
+func (r *repository) GetOrCreate(ctx context.Context, user *entity.User) error {
 	query := r.queryBuilder.
 		Insert(userTable).
 		Columns(userPhone, userName).
 		Values(user.Phone, user.Name).
-		Suffix("RETURNING id")
+		// The no-op update makes RETURNING yield the existing row.
+		Suffix(fmt.Sprintf("ON CONFLICT (%[1]s) DO UPDATE SET %[1]s = EXCLUDED.%[1]s", userPhone)).
+		Suffix("RETURNING " + strings.Join(userColumns, ", "))
 
 	sql, args, err := query.ToSql()
 	if err != nil {
@@ -60,23 +75,30 @@ func (r *repository) Create(ctx context.Context, user *entity.User) error {
 		return err
 	}
 
-	err = conn.QueryRow(ctx, sql, args...).Scan(&user.ID)
-	if err != nil {
-		return fmt.Errorf("insert user: %w", err)
+	if err := scanUser(conn.QueryRow(ctx, sql, args...), user); err != nil {
+		return fmt.Errorf("insert user: %w", pgerrors.Translate(err))
 	}
 
 	return nil
 }
 
+func (r *repository) Get(ctx context.Context, id string) (*entity.User, error) {
+	return r.get(ctx, sq.Eq{userID: id})
+}
+
 func (r *repository) GetByPhone(ctx context.Context, phone string) (*entity.User, error) {
+	return r.get(ctx, sq.Eq{userPhone: phone})
+}
+
+func (r *repository) get(ctx context.Context, where sq.Eq) (*entity.User, error) {
 	query := r.queryBuilder.
-		Select(userID, userPhone, userName).
+		Select(userColumns...).
 		From(userTable).
-		Where(sq.Eq{userPhone: phone})
+		Where(where)
 
 	sql, args, err := query.ToSql()
 	if err != nil {
-		return nil, fmt.Errorf("build get user by phone query: %w", err)
+		return nil, fmt.Errorf("build get user query: %w", err)
 	}
 
 	conn, err := r.transactor.GetConn(ctx)
@@ -85,13 +107,58 @@ func (r *repository) GetByPhone(ctx context.Context, phone string) (*entity.User
 	}
 
 	user := &entity.User{}
-	err = conn.QueryRow(ctx, sql, args...).Scan(&user.ID, &user.Phone, &user.Name)
+	err = scanUser(conn.QueryRow(ctx, sql, args...), user)
 	if err != nil {
-		if err == pgx.ErrNoRows {
-			return nil, nil
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entity.NewNotFoundError("USER_NOT_FOUND", "user not found")
 		}
-		return nil, fmt.Errorf("scan user: %w", err)
+		return nil, fmt.Errorf("scan user: %w", pgerrors.Translate(err))
 	}
 
 	return user, nil
 }
+
+// Update overwrites the profile of the user and fills in the fields that
+// cannot be changed. The phone is the identity of the user and is kept.
+func (r *repository) Update(ctx context.Context, user *entity.User) error {
+	query := r.queryBuilder.
+		Update(userTable).
+		Set(userName, user.Name).
+		Set(userEmail, user.Email).
+		Set(userMarketingConsent, user.MarketingConsent).
+		Where(sq.Eq{userID: user.ID}).
+		Suffix("RETURNING " + strings.Join(userColumns, ", "))
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("build update user query: %w", err)
+	}
+
+	conn, err := r.transactor.GetConn(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = scanUser(conn.QueryRow(ctx, sql, args...), user)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.NewNotFoundError("USER_NOT_FOUND", "user not found").
+				WithMetadata("user_id", user.ID)
+		}
+		return fmt.Errorf("update user: %w", pgerrors.Translate(err))
+	}
+
+	return nil
+}
+
+func scanUser(row pgx.Row, user *entity.User) error {
+	var name *string
+	if err := row.Scan(&user.ID, &user.Phone, &name, &user.Email, &user.MarketingConsent, &user.Role); err != nil {
+		return err
+	}
+	// Customers registered before names were added have none.
+	if name != nil {
+		user.Name = *name
+	}
+	return nil
+}
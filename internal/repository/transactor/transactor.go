@@ -2,97 +2,242 @@ package transactor
 
 import (
 	"context"
-	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	"github.com/Tortik3000/service-order/internal/repository/pgerrors"
+	postgresMetrics "github.com/Tortik3000/service-order/pkg/metrics/postgres"
 	"github.com/Tortik3000/service-order/pkg/postgres"
 )
 
 type Transactor interface {
 	WithTx(ctx context.Context, function func(ctx context.Context) error) error
+	WithTxOptions(ctx context.Context, options pgx.TxOptions, function func(ctx context.Context) error) error
 	GetConn(ctx context.Context) (postgres.Conn, error)
+	BeforeCommit(ctx context.Context, hook func(ctx context.Context) error) context.Context
+}
+
+// RetryPolicy tells WithTx how to retry transactions that failed with an
+// error for which pgerrors.Retryable is true.
+type RetryPolicy struct {
+	// MaxAttempts is how many times a transaction is run at most; 1 turns
+	// retries off.
+	MaxAttempts int
+	// MinBackoff is the delay before the first retry. It doubles with every
+	// following one up to MaxBackoff.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy retries a transaction twice within a few tens of
+// milliseconds.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	MinBackoff:  10 * time.Millisecond,
+	MaxBackoff:  200 * time.Millisecond,
 }
 
 type transactor struct {
-	pool *pgxpool.Pool
+	pool  *pgxpool.Pool
+	retry RetryPolicy
 }
 
 var _ Transactor = (*transactor)(nil)
 
-func New(pool *pgxpool.Pool) *transactor {
+func New(pool *pgxpool.Pool, retry RetryPolicy) *transactor {
 	return &transactor{
-		pool: pool,
+		pool:  pool,
+		retry: retry,
 	}
 }
 
 type (
-	txKey         struct{}
-	txRequiredKey struct{}
+	txKey           struct{}
+	beforeCommitKey struct{}
 )
 
+// txState is stored in the context of a function run by WithTxOptions.
+type txState struct {
+	tx      pgx.Tx
+	options pgx.TxOptions
+}
+
+// WithTx runs function in a transaction with the default options of the
+// database. See WithTxOptions.
 func (t *transactor) WithTx(
 	ctx context.Context,
 	function func(ctx context.Context) error,
-) (txErr error) {
-	tx, err := t.pool.Begin(ctx)
-	if err != nil {
+) error {
+	return t.WithTxOptions(ctx, pgx.TxOptions{}, function)
+}
+
+// WithTxOptions runs function in a transaction started with options, which
+// is committed if function returns nil and rolled back otherwise. A
+// transaction aborted by a serialization failure, a deadlock or a lost
+// connection is run again according to the retry policy, so function must
+// be safe to call several times.
+//
+// Called inside another transaction, WithTxOptions joins it: function runs
+// under a savepoint, which is rolled back if function fails, and is not
+// retried on its own since an aborted outer transaction has to be retried
+// as a whole. options must then leave the isolation level and the access
+// mode empty or equal to those of the outer transaction.
+func (t *transactor) WithTxOptions(
+	ctx context.Context,
+	options pgx.TxOptions,
+	function func(ctx context.Context) error,
+) error {
+	if outer := getTxState(ctx); outer != nil {
+		if err := checkNestedOptions(outer.options, options); err != nil {
+			return err
+		}
+		_, err := runTx(ctx, outer.tx.Begin, outer.options, function)
 		return err
 	}
 
-	defer func() {
-		if txErr != nil {
-			_ = tx.Rollback(ctx)
-			return
+	function = withBeforeCommit(ctx, options, function)
+	begin := func(ctx context.Context) (pgx.Tx, error) {
+		return t.pool.BeginTx(ctx, options)
+	}
+	for attempt := 1; ; attempt++ {
+		retryable, err := runTx(ctx, begin, options, function)
+		if err == nil || !retryable || attempt >= t.retry.MaxAttempts {
+			return err
 		}
 
-		err = tx.Commit(ctx)
-		if err != nil {
-			txErr = err
+		postgresMetrics.TxRetriesTotal.WithLabelValues(retryReason(err)).Inc()
+
+		timer := time.NewTimer(t.retry.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
 		}
-	}()
+	}
+}
 
-	ctxWithTx := context.WithValue(ctx, txKey{}, tx)
-	ctxWithTx = context.WithValue(ctxWithTx, txRequiredKey{}, true)
+// BeforeCommit returns a context in which every read-write transaction that
+// WithTxOptions starts outside of another one runs hook right before its
+// commit. hook runs in the transaction, so what it writes is committed if
+// and only if the transaction is.
+func (t *transactor) BeforeCommit(
+	ctx context.Context,
+	hook func(ctx context.Context) error,
+) context.Context {
+	return context.WithValue(ctx, beforeCommitKey{}, hook)
+}
 
-	return function(ctxWithTx)
+// withBeforeCommit makes function run the hook of ctx, if any, after it
+// succeeds. Read-only transactions have nothing to commit and skip it.
+func withBeforeCommit(
+	ctx context.Context,
+	options pgx.TxOptions,
+	function func(ctx context.Context) error,
+) func(ctx context.Context) error {
+	hook, ok := ctx.Value(beforeCommitKey{}).(func(ctx context.Context) error)
+	if !ok || options.AccessMode == pgx.ReadOnly {
+		return function
+	}
+	return func(ctx context.Context) error {
+		if err := function(ctx); err != nil {
+			return err
+		}
+		return hook(ctx)
+	}
 }
 
-func (t *transactor) GetConn(
+// runTx runs function in a single transaction, or a savepoint, started by
+// begin and reports whether its error is worth a retry.
+func runTx(
 	ctx context.Context,
-) (postgres.Conn, error) {
-	required, err := t.isTransactionRequired(ctx)
+	begin func(ctx context.Context) (pgx.Tx, error),
+	options pgx.TxOptions,
+	function func(ctx context.Context) error,
+) (bool, error) {
+	tx, err := begin(ctx)
 	if err != nil {
-		return nil, err
+		return pgerrors.Retryable(err), err
 	}
+	// Rolling back a committed transaction does nothing; otherwise this
+	// also covers a panicking function.
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
 
-	if required {
-		tx := t.getTx(ctx)
-		return tx, nil
+	ctxWithTx := context.WithValue(ctx, txKey{}, &txState{
+		tx:      tx,
+		options: options,
+	})
+
+	if err := function(ctxWithTx); err != nil {
+		return pgerrors.Retryable(err), err
 	}
 
-	return t.pool, nil
+	if err := tx.Commit(ctx); err != nil {
+		// A commit that lost the connection may have been applied, so only
+		// transactions that Postgres reports as aborted are retried.
+		return pgerrors.Conflict(err), err
+	}
+
+	return false, nil
 }
 
-func (t *transactor) isTransactionRequired(ctx context.Context) (bool, error) {
-	required, ok := ctx.Value(txRequiredKey{}).(bool)
-	if ok {
-		if required {
-			tx := t.getTx(ctx)
-			if tx == nil {
-				return false, errors.New("transaction required, but not found")
-			}
-		}
-		return required, nil
+// checkNestedOptions reports whether a transaction started with outer
+// provides what nested asks for. Only the start of a transaction can set
+// its options, so nested ones cannot be applied.
+func checkNestedOptions(outer, nested pgx.TxOptions) error {
+	if nested.IsoLevel != "" && nested.IsoLevel != outer.IsoLevel {
+		return fmt.Errorf("nested transaction asks for isolation level %q, but the outer one has %q",
+			nested.IsoLevel, outer.IsoLevel)
+	}
+	// A transaction started without an access mode is read write.
+	outerMode := outer.AccessMode
+	if outerMode == "" {
+		outerMode = pgx.ReadWrite
+	}
+	if nested.AccessMode != "" && nested.AccessMode != outerMode {
+		return fmt.Errorf("nested transaction asks for access mode %q, but the outer one has %q",
+			nested.AccessMode, outerMode)
+	}
+	return nil
+}
+
+// backoff returns the delay before the retry following the given attempt,
+// randomised between half and the full value so that transactions that
+// conflicted with each other do not retry in step.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.MaxBackoff
+	if attempt < 20 {
+		d = min(p.MinBackoff<<(attempt-1), p.MaxBackoff)
 	}
+	return d/2 + rand.N(d/2+1)
+}
 
-	return false, nil
+func retryReason(err error) string {
+	if pgerrors.Conflict(err) {
+		return "conflict"
+	}
+	return "connection"
+}
+
+// GetConn returns the transaction of ctx, or the pool outside of one.
+func (t *transactor) GetConn(
+	ctx context.Context,
+) (postgres.Conn, error) {
+	if state := getTxState(ctx); state != nil {
+		return state.tx, nil
+	}
+	return t.pool, nil
 }
 
-func (t *transactor) getTx(ctx context.Context) pgx.Tx {
-	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
-		return tx
+func getTxState(ctx context.Context) *txState {
+	if state, ok := ctx.Value(txKey{}).(*txState); ok {
+		return state
 	}
 	return nil
 }
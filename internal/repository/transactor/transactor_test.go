@@ -0,0 +1,319 @@
+package transactor
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// fakeTx records what happened to a transaction or a savepoint. Methods
+// that the tests do not need panic through the nil embedded pgx.Tx.
+type fakeTx struct {
+	pgx.Tx
+
+	commitErr  error
+	committed  bool
+	rolledBack bool
+	savepoints []*fakeTx
+}
+
+func (tx *fakeTx) Begin(context.Context) (pgx.Tx, error) {
+	savepoint := &fakeTx{}
+	tx.savepoints = append(tx.savepoints, savepoint)
+	return savepoint, nil
+}
+
+func (tx *fakeTx) Commit(context.Context) error {
+	if tx.commitErr != nil {
+		tx.rolledBack = true
+		return tx.commitErr
+	}
+	tx.committed = true
+	return nil
+}
+
+func (tx *fakeTx) Rollback(context.Context) error {
+	if tx.committed || tx.rolledBack {
+		return pgx.ErrTxClosed
+	}
+	tx.rolledBack = true
+	return nil
+}
+
+func beginFake(tx *fakeTx) func(context.Context) (pgx.Tx, error) {
+	return func(context.Context) (pgx.Tx, error) {
+		return tx, nil
+	}
+}
+
+func TestRunTxRetryable(t *testing.T) {
+	conflict := &pgconn.PgError{Code: "40001"}
+	connLost := io.ErrUnexpectedEOF
+
+	tests := []struct {
+		name      string
+		fnErr     error
+		commitErr error
+		retryable bool
+	}{
+		{name: "success"},
+		{name: "function error", fnErr: errors.New("boom")},
+		{name: "function conflict", fnErr: conflict, retryable: true},
+		{name: "function lost connection", fnErr: connLost, retryable: true},
+		{name: "commit conflict", commitErr: conflict, retryable: true},
+		// The commit may have been applied before the connection was lost.
+		{name: "commit lost connection", commitErr: connLost},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &fakeTx{commitErr: tt.commitErr}
+			retryable, err := runTx(context.Background(), beginFake(tx), pgx.TxOptions{},
+				func(context.Context) error { return tt.fnErr })
+
+			wantErr := tt.fnErr
+			if wantErr == nil {
+				wantErr = tt.commitErr
+			}
+			if !errors.Is(err, wantErr) {
+				t.Fatalf("runTx() error = %v, want %v", err, wantErr)
+			}
+			if retryable != tt.retryable {
+				t.Errorf("runTx() retryable = %v, want %v", retryable, tt.retryable)
+			}
+			if wantCommit := tt.fnErr == nil && tt.commitErr == nil; tx.committed != wantCommit {
+				t.Errorf("committed = %v, want %v", tx.committed, wantCommit)
+			}
+			if tt.fnErr != nil && !tx.rolledBack {
+				t.Error("transaction of a failed function was not rolled back")
+			}
+		})
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{
+		MaxAttempts: 10,
+		MinBackoff:  10 * time.Millisecond,
+		MaxBackoff:  200 * time.Millisecond,
+	}
+
+	tests := []struct {
+		attempt int
+		cap     time.Duration
+	}{
+		{attempt: 1, cap: 10 * time.Millisecond},
+		{attempt: 2, cap: 20 * time.Millisecond},
+		{attempt: 5, cap: 160 * time.Millisecond},
+		{attempt: 6, cap: 200 * time.Millisecond},
+		{attempt: 19, cap: 200 * time.Millisecond},
+		// Shifting MinBackoff this far would overflow.
+		{attempt: 64, cap: 200 * time.Millisecond},
+		{attempt: 1000, cap: 200 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		for range 100 {
+			d := policy.backoff(tt.attempt)
+			if d < tt.cap/2 || d > tt.cap {
+				t.Fatalf("backoff(%d) = %v, want between %v and %v", tt.attempt, d, tt.cap/2, tt.cap)
+			}
+		}
+	}
+}
+
+func TestNestedRollbackKeepsOuter(t *testing.T) {
+	tr := &transactor{retry: DefaultRetryPolicy}
+	outer := &fakeTx{}
+	innerErr := errors.New("inner failed")
+
+	retryable, err := runTx(context.Background(), beginFake(outer), pgx.TxOptions{}, func(ctx context.Context) error {
+		if err := tr.WithTx(ctx, func(context.Context) error { return innerErr }); !errors.Is(err, innerErr) {
+			t.Errorf("nested WithTx() error = %v, want %v", err, innerErr)
+		}
+		return tr.WithTx(ctx, func(context.Context) error { return nil })
+	})
+	if err != nil || retryable {
+		t.Fatalf("runTx() = %v, %v, want false, nil", retryable, err)
+	}
+
+	if len(outer.savepoints) != 2 {
+		t.Fatalf("got %d savepoints, want 2", len(outer.savepoints))
+	}
+	if failed := outer.savepoints[0]; !failed.rolledBack || failed.committed {
+		t.Error("savepoint of the failed function was not rolled back")
+	}
+	if succeeded := outer.savepoints[1]; !succeeded.committed {
+		t.Error("savepoint of the successful function was not released")
+	}
+	if !outer.committed {
+		t.Error("outer transaction was not committed after a nested rollback")
+	}
+}
+
+func TestNestedOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		outer   pgx.TxOptions
+		nested  pgx.TxOptions
+		wantErr bool
+	}{
+		{name: "defaults", outer: pgx.TxOptions{}, nested: pgx.TxOptions{}},
+		{
+			name:   "inherits isolation level",
+			outer:  pgx.TxOptions{IsoLevel: pgx.Serializable},
+			nested: pgx.TxOptions{},
+		},
+		{
+			name:   "same isolation level",
+			outer:  pgx.TxOptions{IsoLevel: pgx.Serializable},
+			nested: pgx.TxOptions{IsoLevel: pgx.Serializable},
+		},
+		{
+			name:    "other isolation level",
+			outer:   pgx.TxOptions{IsoLevel: pgx.ReadCommitted},
+			nested:  pgx.TxOptions{IsoLevel: pgx.Serializable},
+			wantErr: true,
+		},
+		{
+			name:    "isolation level in a default transaction",
+			outer:   pgx.TxOptions{},
+			nested:  pgx.TxOptions{IsoLevel: pgx.RepeatableRead},
+			wantErr: true,
+		},
+		{
+			name:   "inherits access mode",
+			outer:  pgx.TxOptions{AccessMode: pgx.ReadOnly},
+			nested: pgx.TxOptions{},
+		},
+		{
+			name:   "same access mode",
+			outer:  pgx.TxOptions{AccessMode: pgx.ReadOnly},
+			nested: pgx.TxOptions{AccessMode: pgx.ReadOnly},
+		},
+		{
+			name:   "read write in a default transaction",
+			outer:  pgx.TxOptions{},
+			nested: pgx.TxOptions{AccessMode: pgx.ReadWrite},
+		},
+		{
+			name:    "read only in read write",
+			outer:   pgx.TxOptions{AccessMode: pgx.ReadWrite},
+			nested:  pgx.TxOptions{AccessMode: pgx.ReadOnly},
+			wantErr: true,
+		},
+		{
+			name:    "read only in a default transaction",
+			outer:   pgx.TxOptions{},
+			nested:  pgx.TxOptions{AccessMode: pgx.ReadOnly},
+			wantErr: true,
+		},
+		{
+			name:    "read write in read only",
+			outer:   pgx.TxOptions{AccessMode: pgx.ReadOnly},
+			nested:  pgx.TxOptions{AccessMode: pgx.ReadWrite},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkNestedOptions(tt.outer, tt.nested); (err != nil) != tt.wantErr {
+				t.Fatalf("checkNestedOptions() error = %v, want error %v", err, tt.wantErr)
+			}
+
+			// A rejected nested transaction must not start a savepoint.
+			outer := &fakeTx{}
+			ctx := context.WithValue(context.Background(), txKey{}, &txState{tx: outer, options: tt.outer})
+			called := false
+			err := (&transactor{}).WithTxOptions(ctx, tt.nested, func(context.Context) error {
+				called = true
+				return nil
+			})
+			if (err != nil) != tt.wantErr || called == tt.wantErr || (len(outer.savepoints) == 0) != tt.wantErr {
+				t.Errorf("WithTxOptions() error = %v, called = %v, savepoints = %d",
+					err, called, len(outer.savepoints))
+			}
+		})
+	}
+}
+
+func TestBeforeCommit(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  pgx.TxOptions
+		fnErr    error
+		wantHook bool
+	}{
+		{name: "read write", options: pgx.TxOptions{}, wantHook: true},
+		{name: "serializable", options: pgx.TxOptions{IsoLevel: pgx.Serializable}, wantHook: true},
+		{name: "read only", options: pgx.TxOptions{AccessMode: pgx.ReadOnly}},
+		{name: "failed function", options: pgx.TxOptions{}, fnErr: errors.New("boom")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &fakeTx{}
+			var hookCalls int
+			var hookInTx bool
+			ctx := (&transactor{}).BeforeCommit(context.Background(), func(ctx context.Context) error {
+				hookCalls++
+				hookInTx = getTxState(ctx) != nil && getTxState(ctx).tx == tx
+				return nil
+			})
+
+			function := withBeforeCommit(ctx, tt.options, func(context.Context) error { return tt.fnErr })
+			_, err := runTx(ctx, beginFake(tx), tt.options, function)
+			if !errors.Is(err, tt.fnErr) {
+				t.Fatalf("runTx() error = %v, want %v", err, tt.fnErr)
+			}
+			want := 0
+			if tt.wantHook {
+				want = 1
+			}
+			if hookCalls != want {
+				t.Fatalf("hook called %d times, want %d", hookCalls, want)
+			}
+			if tt.wantHook && !hookInTx {
+				t.Error("hook did not run in the transaction")
+			}
+		})
+	}
+
+	t.Run("failed hook rolls back", func(t *testing.T) {
+		tx := &fakeTx{}
+		hookErr := errors.New("hook failed")
+		ctx := (&transactor{}).BeforeCommit(context.Background(), func(context.Context) error { return hookErr })
+
+		function := withBeforeCommit(ctx, pgx.TxOptions{}, func(context.Context) error { return nil })
+		if _, err := runTx(ctx, beginFake(tx), pgx.TxOptions{}, function); !errors.Is(err, hookErr) {
+			t.Fatalf("runTx() error = %v, want %v", err, hookErr)
+		}
+		if tx.committed || !tx.rolledBack {
+			t.Error("transaction of a failed hook was committed")
+		}
+	})
+
+	t.Run("nested transactions skip the hook", func(t *testing.T) {
+		outer := &fakeTx{}
+		var hookCalls int
+		tr := &transactor{}
+		ctx := tr.BeforeCommit(context.Background(), func(context.Context) error {
+			hookCalls++
+			return nil
+		})
+		ctx = context.WithValue(ctx, txKey{}, &txState{tx: outer})
+
+		if err := tr.WithTx(ctx, func(context.Context) error { return nil }); err != nil {
+			t.Fatalf("nested WithTx() error = %v", err)
+		}
+		if hookCalls != 0 {
+			t.Errorf("hook called %d times in a savepoint, want 0", hookCalls)
+		}
+	})
+}
@@ -0,0 +1,318 @@
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/Tortik3000/service-order/internal/domain/entity"
+	"github.com/Tortik3000/service-order/pkg/token"
+)
+
+const (
+	codeDigits = 6
+	codeTTL    = 5 * time.Minute
+	// resendInterval is how long a phone has to wait before another code is
+	// sent to it.
+	resendInterval = time.Minute
+	// maxAttempts is how many wrong codes are accepted before the code is
+	// dropped and the phone is locked out.
+	maxAttempts = 5
+	// lockoutPeriod is how long a phone that ran out of attempts has to wait
+	// for a new code.
+	lockoutPeriod = 30 * time.Minute
+)
+
+type Usecase interface {
+	RequestOtp(ctx context.Context, phone string) (time.Time, error)
+	VerifyOtp(ctx context.Context, phone, code string) (*entity.User, *entity.Tokens, error)
+	RefreshTokens(ctx context.Context, refreshToken string) (*entity.Tokens, error)
+}
+
+type (
+	otpRepository interface {
+		Save(ctx context.Context, code *entity.OtpCode) error
+		GetForUpdate(ctx context.Context, phone string) (*entity.OtpCode, error)
+		IncrementAttempts(ctx context.Context, phone string) error
+		Lock(ctx context.Context, phone string, until time.Time) error
+		Delete(ctx context.Context, phone string) error
+	}
+
+	userRepository interface {
+		GetOrCreate(ctx context.Context, user *entity.User) error
+		Get(ctx context.Context, id string) (*entity.User, error)
+	}
+
+	smsSender interface {
+		Send(ctx context.Context, phone, text string) error
+	}
+
+	tokenIssuer interface {
+		Issue(kind token.Kind, subject string, role int32) (string, time.Time, error)
+		Parse(kind token.Kind, signed string) (*token.Claims, error)
+	}
+
+	phoneParser interface {
+		Normalize(number string) (string, error)
+	}
+
+	txManager interface {
+		WithTx(ctx context.Context, function func(ctx context.Context) error) error
+	}
+)
+
+type useCase struct {
+	otpRepo    otpRepository
+	userRepo   userRepository
+	sms        smsSender
+	tokens     tokenIssuer
+	phones     phoneParser
+	transactor txManager
+	// codeKey keys the hashes of stored codes, so that a leaked table does
+	// not give the codes away to a brute force over a million values.
+	codeKey []byte
+}
+
+var _ Usecase = (*useCase)(nil)
+
+func NewUseCase(
+	otpRepo otpRepository,
+	userRepo userRepository,
+	sms smsSender,
+	tokens tokenIssuer,
+	phones phoneParser,
+	transactor txManager,
+	codeKey []byte,
+) *useCase {
+	return &useCase{
+		otpRepo:    otpRepo,
+		userRepo:   userRepo,
+		sms:        sms,
+		tokens:     tokens,
+		phones:     phones,
+		transactor: transactor,
+		codeKey:    codeKey,
+	}
+}
+
+// RequestOtp sends a new one-time code to the phone and returns when it
+// expires. A previous code of the phone stops working.
+func (u *useCase) RequestOtp(ctx context.Context, phone string) (time.Time, error) {
+	phone, err := u.normalizePhone(phone)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	code, err := generateCode()
+	if err != nil {
+		return time.Time{}, err
+	}
+	otp := &entity.OtpCode{
+		Phone:     phone,
+		CodeHash:  u.hash(phone, code),
+		ExpiresAt: time.Now().Add(codeTTL),
+	}
+
+	err = u.transactor.WithTx(ctx, func(ctx context.Context) error {
+		previous, err := u.otpRepo.GetForUpdate(ctx, phone)
+		if err != nil && !errors.Is(err, entity.ErrNotFound) {
+			return fmt.Errorf("get otp code: %w", err)
+		}
+		if previous != nil {
+			if wait := time.Until(previous.LockedUntil); wait > 0 {
+				return lockedError(wait)
+			}
+			if wait := time.Until(previous.CreatedAt.Add(resendInterval)); wait > 0 {
+				return entity.NewResourceExhaustedError("OTP_RESEND_TOO_EARLY", "a code was sent recently, try again later").
+					WithMetadata("retry_after_seconds", retryAfter(wait))
+			}
+		}
+
+		if err := u.otpRepo.Save(ctx, otp); err != nil {
+			return fmt.Errorf("save otp code: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// The code is sent after the commit, so that a retried transaction does
+	// not send it twice. If sending fails, the code stays saved and the phone
+	// can get a new one after resendInterval.
+	if err := u.sms.Send(ctx, phone, fmt.Sprintf("Your login code: %s", code)); err != nil {
+		return time.Time{}, fmt.Errorf("send otp code: %w", err)
+	}
+
+	return otp.ExpiresAt, nil
+}
+
+// VerifyOtp checks the code sent to the phone and logs the user in,
+// registering a new user for an unknown phone. A code works only once. After
+// maxAttempts wrong codes the phone is locked out for lockoutPeriod; the
+// expired or locked code is kept, so that RequestOtp still enforces the
+// resend interval and the lockout.
+func (u *useCase) VerifyOtp(ctx context.Context, phone, code string) (*entity.User, *entity.Tokens, error) {
+	phone, err := u.normalizePhone(phone)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		user *entity.User
+		// verifyErr is returned after the transaction commits, so that a
+		// wrong code still counts as an attempt.
+		verifyErr error
+	)
+	err = u.transactor.WithTx(ctx, func(ctx context.Context) error {
+		user, verifyErr = nil, nil
+
+		otp, err := u.otpRepo.GetForUpdate(ctx, phone)
+		if err != nil {
+			if errors.Is(err, entity.ErrNotFound) {
+				verifyErr = codeExpiredError()
+				return nil
+			}
+			return fmt.Errorf("get otp code: %w", err)
+		}
+
+		if wait := time.Until(otp.LockedUntil); wait > 0 {
+			verifyErr = lockedError(wait)
+			return nil
+		}
+		if time.Now().After(otp.ExpiresAt) {
+			verifyErr = codeExpiredError()
+			return nil
+		}
+
+		if !hmac.Equal(otp.CodeHash, u.hash(phone, code)) {
+			attemptsLeft := maxAttempts - otp.Attempts - 1
+			verifyErr = entity.NewInvalidArgumentError("INVALID_OTP", "wrong code").
+				WithMetadata("attempts_left", strconv.Itoa(int(attemptsLeft))).
+				WithViolation("code", "code does not match the one sent to the phone")
+			if attemptsLeft <= 0 {
+				if err := u.otpRepo.Lock(ctx, phone, time.Now().Add(lockoutPeriod)); err != nil {
+					return fmt.Errorf("lock otp code: %w", err)
+				}
+				return nil
+			}
+			if err := u.otpRepo.IncrementAttempts(ctx, phone); err != nil {
+				return fmt.Errorf("increment otp attempts: %w", err)
+			}
+			return nil
+		}
+
+		if err := u.deleteCode(ctx, phone); err != nil {
+			return err
+		}
+		user = &entity.User{Phone: phone}
+		if err := u.userRepo.GetOrCreate(ctx, user); err != nil {
+			return fmt.Errorf("register user: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if verifyErr != nil {
+		return nil, nil, verifyErr
+	}
+
+	tokens, err := u.issueTokens(user)
+	if err != nil {
+		return nil, nil, err
+	}
+	return user, tokens, nil
+}
+
+// RefreshTokens exchanges a refresh token for new tokens. The user is read
+// again, so a changed role is picked up.
+func (u *useCase) RefreshTokens(ctx context.Context, refreshToken string) (*entity.Tokens, error) {
+	claims, err := u.tokens.Parse(token.KindRefresh, refreshToken)
+	if err != nil {
+		return nil, invalidTokenError(err)
+	}
+
+	user, err := u.userRepo.Get(ctx, claims.Subject)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return nil, invalidTokenError(err)
+		}
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+
+	return u.issueTokens(user)
+}
+
+func (u *useCase) issueTokens(user *entity.User) (*entity.Tokens, error) {
+	access, expiresAt, err := u.tokens.Issue(token.KindAccess, user.ID, int32(user.Role))
+	if err != nil {
+		return nil, fmt.Errorf("issue access token: %w", err)
+	}
+	refresh, _, err := u.tokens.Issue(token.KindRefresh, user.ID, int32(user.Role))
+	if err != nil {
+		return nil, fmt.Errorf("issue refresh token: %w", err)
+	}
+
+	return &entity.Tokens{
+		AccessToken:          access,
+		AccessTokenExpiresAt: expiresAt,
+		RefreshToken:         refresh,
+	}, nil
+}
+
+func (u *useCase) deleteCode(ctx context.Context, phone string) error {
+	if err := u.otpRepo.Delete(ctx, phone); err != nil {
+		return fmt.Errorf("delete otp code: %w", err)
+	}
+	return nil
+}
+
+func (u *useCase) hash(phone, code string) []byte {
+	mac := hmac.New(sha256.New, u.codeKey)
+	mac.Write([]byte(phone))
+	mac.Write([]byte{0})
+	mac.Write([]byte(code))
+	return mac.Sum(nil)
+}
+
+func (u *useCase) normalizePhone(phone string) (string, error) {
+	normalized, err := u.phones.Normalize(phone)
+	if err != nil {
+		return "", entity.NewInvalidArgumentError("INVALID_PHONE", "invalid phone number").
+			WithViolation("phone", "phone must be a valid phone number").
+			WithCause(err)
+	}
+	return normalized, nil
+}
+
+func generateCode() (string, error) {
+	n, err := rand.Int(rand.Reader, new(big.Int).Exp(big.NewInt(10), big.NewInt(codeDigits), nil))
+	if err != nil {
+		return "", fmt.Errorf("generate otp code: %w", err)
+	}
+	return fmt.Sprintf("%0*d", codeDigits, n.Int64()), nil
+}
+
+func codeExpiredError() error {
+	return entity.NewFailedPreconditionError("OTP_EXPIRED", "code expired or was not requested, request a new one")
+}
+
+func lockedError(wait time.Duration) error {
+	return entity.NewResourceExhaustedError("OTP_LOCKED", "too many wrong codes, try again later").
+		WithMetadata("retry_after_seconds", retryAfter(wait))
+}
+
+func retryAfter(wait time.Duration) string {
+	return strconv.Itoa(int(wait.Seconds()) + 1)
+}
+
+func invalidTokenError(cause error) error {
+	return entity.NewUnauthenticatedError("INVALID_TOKEN", "invalid or expired token").WithCause(cause)
+}
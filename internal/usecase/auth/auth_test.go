@@ -0,0 +1,179 @@
+package auth_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/Tortik3000/service-order/internal/domain/entity"
+	"github.com/Tortik3000/service-order/internal/usecase/auth"
+	"github.com/Tortik3000/service-order/internal/usecase/user"
+	"github.com/Tortik3000/service-order/pkg/phone"
+	"github.com/Tortik3000/service-order/pkg/token"
+)
+
+// userRepository keeps users in memory, unique by phone like the customer
+// table.
+type userRepository struct {
+	byPhone map[string]*entity.User
+}
+
+func (r *userRepository) GetOrCreate(_ context.Context, u *entity.User) error {
+	if existing, ok := r.byPhone[u.Phone]; ok {
+		*u = *existing
+		return nil
+	}
+	u.ID = fmt.Sprintf("user-%d", len(r.byPhone)+1)
+	stored := *u
+	r.byPhone[u.Phone] = &stored
+	return nil
+}
+
+func (r *userRepository) Get(_ context.Context, id string) (*entity.User, error) {
+	for _, u := range r.byPhone {
+		if u.ID == id {
+			res := *u
+			return &res, nil
+		}
+	}
+	return nil, entity.NewNotFoundError("USER_NOT_FOUND", "user not found")
+}
+
+func (r *userRepository) GetByPhone(_ context.Context, phone string) (*entity.User, error) {
+	if u, ok := r.byPhone[phone]; ok {
+		res := *u
+		return &res, nil
+	}
+	return nil, entity.NewNotFoundError("USER_NOT_FOUND", "user not found")
+}
+
+func (r *userRepository) Update(context.Context, *entity.User) error {
+	return nil
+}
+
+type otpRepository struct {
+	codes map[string]*entity.OtpCode
+}
+
+func (r *otpRepository) Save(_ context.Context, code *entity.OtpCode) error {
+	stored := *code
+	stored.CreatedAt = time.Now()
+	r.codes[code.Phone] = &stored
+	return nil
+}
+
+func (r *otpRepository) GetForUpdate(_ context.Context, phone string) (*entity.OtpCode, error) {
+	if code, ok := r.codes[phone]; ok {
+		res := *code
+		return &res, nil
+	}
+	return nil, entity.NewNotFoundError("OTP_NOT_FOUND", "otp code not found")
+}
+
+func (r *otpRepository) IncrementAttempts(_ context.Context, phone string) error {
+	r.codes[phone].Attempts++
+	return nil
+}
+
+func (r *otpRepository) Lock(_ context.Context, phone string, until time.Time) error {
+	r.codes[phone].LockedUntil = until
+	return nil
+}
+
+func (r *otpRepository) Delete(_ context.Context, phone string) error {
+	delete(r.codes, phone)
+	return nil
+}
+
+// smsSender remembers the last code sent to every phone.
+type smsSender struct {
+	codes map[string]string
+}
+
+var codeRe = regexp.MustCompile(`\d{6}`)
+
+func (s *smsSender) Send(_ context.Context, phone, text string) error {
+	s.codes[phone] = codeRe.FindString(text)
+	return nil
+}
+
+type transactor struct{}
+
+func (transactor) WithTx(ctx context.Context, function func(ctx context.Context) error) error {
+	return function(ctx)
+}
+
+// TestSelfAndStaffRegistrationShareUser checks that a customer who logs in
+// with a code and one registered by staff are the same user, however the
+// phone is written.
+func TestSelfAndStaffRegistrationShareUser(t *testing.T) {
+	tests := []struct {
+		name       string
+		otpPhone   string
+		staffPhone string
+	}{
+		{name: "same spelling", otpPhone: "+79001234567", staffPhone: "+79001234567"},
+		{name: "national format", otpPhone: "8 (900) 123-45-67", staffPhone: "+7 900 123 45 67"},
+		{name: "without plus", otpPhone: "+79001234567", staffPhone: "79001234567"},
+		{name: "national number", otpPhone: "9001234567", staffPhone: "8-900-123-45-67"},
+	}
+
+	phones, err := phone.NewParser("RU")
+	if err != nil {
+		t.Fatalf("NewParser() error = %v", err)
+	}
+	tokens := token.NewIssuer([]byte("test-token-secret-0123456789"), "test", time.Minute, time.Hour)
+
+	for _, tt := range tests {
+		for _, staffFirst := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%s/staff first %v", tt.name, staffFirst), func(t *testing.T) {
+				users := &userRepository{byPhone: make(map[string]*entity.User)}
+				sms := &smsSender{codes: make(map[string]string)}
+				authUC := auth.NewUseCase(&otpRepository{codes: make(map[string]*entity.OtpCode)}, users, sms, tokens, phones, transactor{}, []byte("test-otp-secret-0123456789"))
+				userUC := user.NewUseCase(users, phones)
+				ctx := context.Background()
+
+				register := func() *entity.User {
+					u, err := userUC.RegisterUser(ctx, tt.staffPhone, "Customer")
+					if err != nil {
+						t.Fatalf("RegisterUser() error = %v", err)
+					}
+					return u
+				}
+
+				var staffUser *entity.User
+				if staffFirst {
+					staffUser = register()
+				}
+
+				if _, err := authUC.RequestOtp(ctx, tt.otpPhone); err != nil {
+					t.Fatalf("RequestOtp() error = %v", err)
+				}
+				if len(sms.codes) != 1 {
+					t.Fatalf("sent codes to %d phones, want 1", len(sms.codes))
+				}
+				var code string
+				for _, c := range sms.codes {
+					code = c
+				}
+				selfUser, _, err := authUC.VerifyOtp(ctx, tt.otpPhone, code)
+				if err != nil {
+					t.Fatalf("VerifyOtp() error = %v", err)
+				}
+
+				if !staffFirst {
+					staffUser = register()
+				}
+
+				if len(users.byPhone) != 1 {
+					t.Fatalf("stored %d users, want 1: %v", len(users.byPhone), users.byPhone)
+				}
+				if selfUser.ID != staffUser.ID {
+					t.Errorf("VerifyOtp() user %s, RegisterUser() user %s, want the same", selfUser.ID, staffUser.ID)
+				}
+			})
+		}
+	}
+}
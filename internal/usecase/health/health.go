@@ -0,0 +1,104 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+type Usecase interface {
+	Ready(ctx context.Context) error
+	Shutdown()
+}
+
+type (
+	pinger interface {
+		Ping(ctx context.Context) error
+	}
+
+	migrationChecker interface {
+		HasPending(ctx context.Context) (bool, error)
+	}
+
+	outboxRepository interface {
+		OldestPending(ctx context.Context) (time.Time, error)
+	}
+)
+
+// CheckError tells which readiness check failed.
+type CheckError struct {
+	Check string
+	Err   error
+}
+
+func (e *CheckError) Error() string {
+	return e.Check + ": " + e.Err.Error()
+}
+
+func (e *CheckError) Unwrap() error {
+	return e.Err
+}
+
+type useCase struct {
+	db         pinger
+	migrations migrationChecker
+	outboxRepo outboxRepository
+	// maxOutboxLag is how long a message may wait in the outbox before the
+	// service is reported as not ready; zero turns the check off.
+	maxOutboxLag time.Duration
+	shuttingDown atomic.Bool
+}
+
+var _ Usecase = (*useCase)(nil)
+
+func NewUseCase(db pinger, migrations migrationChecker, outboxRepo outboxRepository, maxOutboxLag time.Duration) *useCase {
+	return &useCase{
+		db:           db,
+		migrations:   migrations,
+		outboxRepo:   outboxRepo,
+		maxOutboxLag: maxOutboxLag,
+	}
+}
+
+// Ready returns nil if the service can take requests: it is not shutting
+// down, the database answers and is fully migrated, and order events are
+// not stuck in the outbox.
+func (u *useCase) Ready(ctx context.Context) error {
+	if u.shuttingDown.Load() {
+		return &CheckError{Check: "shutdown", Err: errors.New("service is shutting down")}
+	}
+
+	if err := u.db.Ping(ctx); err != nil {
+		return &CheckError{Check: "database", Err: err}
+	}
+
+	pending, err := u.migrations.HasPending(ctx)
+	if err != nil {
+		return &CheckError{Check: "migrations", Err: err}
+	}
+	if pending {
+		return &CheckError{Check: "migrations", Err: errors.New("database migrations are pending")}
+	}
+
+	if u.maxOutboxLag > 0 {
+		oldest, err := u.outboxRepo.OldestPending(ctx)
+		if err != nil {
+			return &CheckError{Check: "outbox", Err: err}
+		}
+		if lag := time.Since(oldest); !oldest.IsZero() && lag > u.maxOutboxLag {
+			return &CheckError{
+				Check: "outbox",
+				Err:   fmt.Errorf("oldest pending message is %s old, more than %s", lag.Round(time.Second), u.maxOutboxLag),
+			}
+		}
+	}
+
+	return nil
+}
+
+// Shutdown makes the service report itself as not ready from now on.
+func (u *useCase) Shutdown() {
+	u.shuttingDown.Store(true)
+}
@@ -0,0 +1,130 @@
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Tortik3000/service-order/internal/domain/entity"
+)
+
+const (
+	// keyTTL is how long a key and its stored response are kept.
+	keyTTL = 24 * time.Hour
+	// staleAfter is how long a key may stay reserved without a response
+	// before another request is allowed to take it over.
+	staleAfter = time.Minute
+)
+
+type Usecase interface {
+	Do(ctx context.Context, userID, key, method string, requestHash []byte, fn func(ctx context.Context) ([]byte, error)) ([]byte, error)
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+type (
+	idempotencyRepository interface {
+		Reserve(ctx context.Context, key *entity.IdempotencyKey, staleAfter time.Duration) (bool, error)
+		Get(ctx context.Context, userID, key, method string) (*entity.IdempotencyKey, error)
+		MarkCompleted(ctx context.Context, userID, key, method string) error
+		SaveResponse(ctx context.Context, userID, key, method string, response []byte) error
+		Release(ctx context.Context, userID, key, method string) error
+		DeleteExpired(ctx context.Context) (int64, error)
+	}
+
+	txManager interface {
+		BeforeCommit(ctx context.Context, hook func(ctx context.Context) error) context.Context
+	}
+)
+
+type useCase struct {
+	keyRepo    idempotencyRepository
+	transactor txManager
+}
+
+var _ Usecase = (*useCase)(nil)
+
+func NewUseCase(keyRepo idempotencyRepository, transactor txManager) *useCase {
+	return &useCase{
+		keyRepo:    keyRepo,
+		transactor: transactor,
+	}
+}
+
+// Do runs fn once per user, key and method and returns its serialized
+// response. Later calls of the user with the same key and request hash get
+// the stored response without running fn; a different request hash is
+// rejected. If fn fails the key is released, so the request can be retried
+// with the same key.
+//
+// The key is marked completed in the transactions fn commits, so once fn
+// has changed anything it is never run again for the key: if its response
+// cannot be stored afterwards, later calls get an error instead.
+func (u *useCase) Do(
+	ctx context.Context,
+	userID, key, method string,
+	requestHash []byte,
+	fn func(ctx context.Context) ([]byte, error),
+) ([]byte, error) {
+	reserved, err := u.keyRepo.Reserve(ctx, &entity.IdempotencyKey{
+		UserID:      userID,
+		Key:         key,
+		Method:      method,
+		RequestHash: requestHash,
+		ExpiresAt:   time.Now().Add(keyTTL),
+	}, staleAfter)
+	if err != nil {
+		return nil, fmt.Errorf("reserve idempotency key: %w", err)
+	}
+
+	if !reserved {
+		existing, err := u.keyRepo.Get(ctx, userID, key, method)
+		if err != nil {
+			return nil, fmt.Errorf("get idempotency key: %w", err)
+		}
+		if !bytes.Equal(existing.RequestHash, requestHash) {
+			return nil, entity.NewInvalidArgumentError(
+				"IDEMPOTENCY_KEY_REUSED",
+				"idempotency key was already used with a different request",
+			)
+		}
+		if existing.Response == nil && existing.Completed {
+			return nil, entity.NewFailedPreconditionError(
+				"IDEMPOTENCY_RESPONSE_LOST",
+				"the request with this idempotency key was processed, but its response was not stored",
+			)
+		}
+		if existing.Response == nil {
+			return nil, entity.NewConflictError(
+				"IDEMPOTENCY_KEY_IN_PROGRESS",
+				"a request with this idempotency key is still being processed",
+			)
+		}
+		return existing.Response, nil
+	}
+
+	fnCtx := u.transactor.BeforeCommit(ctx, func(ctx context.Context) error {
+		if err := u.keyRepo.MarkCompleted(ctx, userID, key, method); err != nil {
+			return fmt.Errorf("mark idempotency key completed: %w", err)
+		}
+		return nil
+	})
+	response, err := fn(fnCtx)
+	if err != nil {
+		// Use a fresh context: the request context may be the reason fn failed.
+		if releaseErr := u.keyRepo.Release(context.WithoutCancel(ctx), userID, key, method); releaseErr != nil {
+			return nil, fmt.Errorf("%w (release idempotency key: %v)", err, releaseErr)
+		}
+		return nil, err
+	}
+
+	if err := u.keyRepo.SaveResponse(context.WithoutCancel(ctx), userID, key, method, response); err != nil {
+		return nil, fmt.Errorf("save idempotency response: %w", err)
+	}
+
+	return response, nil
+}
+
+func (u *useCase) DeleteExpired(ctx context.Context) (int64, error) {
+	return u.keyRepo.DeleteExpired(ctx)
+}
@@ -0,0 +1,176 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Tortik3000/service-order/internal/domain/entity"
+)
+
+// fakeRepository keeps keys in memory. Reserve takes over every key whose
+// request has not completed, as if it had gone stale.
+type fakeRepository struct {
+	keys    map[string]*entity.IdempotencyKey
+	saveErr error
+}
+
+func newFakeRepository() *fakeRepository {
+	return &fakeRepository{keys: make(map[string]*entity.IdempotencyKey)}
+}
+
+func (r *fakeRepository) Reserve(_ context.Context, key *entity.IdempotencyKey, _ time.Duration) (bool, error) {
+	id := key.UserID + "/" + key.Key + "/" + key.Method
+	if existing, ok := r.keys[id]; ok && (existing.Response != nil || existing.Completed) {
+		return false, nil
+	}
+	reserved := *key
+	r.keys[id] = &reserved
+	return true, nil
+}
+
+func (r *fakeRepository) Get(_ context.Context, userID, key, method string) (*entity.IdempotencyKey, error) {
+	existing, ok := r.keys[userID+"/"+key+"/"+method]
+	if !ok {
+		return nil, entity.NewNotFoundError("IDEMPOTENCY_KEY_NOT_FOUND", "idempotency key not found")
+	}
+	res := *existing
+	return &res, nil
+}
+
+func (r *fakeRepository) MarkCompleted(_ context.Context, userID, key, method string) error {
+	r.keys[userID+"/"+key+"/"+method].Completed = true
+	return nil
+}
+
+func (r *fakeRepository) SaveResponse(_ context.Context, userID, key, method string, response []byte) error {
+	if r.saveErr != nil {
+		return r.saveErr
+	}
+	existing := r.keys[userID+"/"+key+"/"+method]
+	existing.Response, existing.Completed = response, true
+	return nil
+}
+
+func (r *fakeRepository) Release(_ context.Context, userID, key, method string) error {
+	id := userID + "/" + key + "/" + method
+	if !r.keys[id].Completed {
+		delete(r.keys, id)
+	}
+	return nil
+}
+
+func (r *fakeRepository) DeleteExpired(context.Context) (int64, error) {
+	return 0, nil
+}
+
+type hookKey struct{}
+
+// fakeTransactor hands the hook to commit, which the functions passed to
+// Do call where a real handler would commit a transaction.
+type fakeTransactor struct{}
+
+func (fakeTransactor) BeforeCommit(ctx context.Context, hook func(ctx context.Context) error) context.Context {
+	return context.WithValue(ctx, hookKey{}, hook)
+}
+
+func commit(ctx context.Context) error {
+	return ctx.Value(hookKey{}).(func(ctx context.Context) error)(ctx)
+}
+
+func TestDo(t *testing.T) {
+	errHandler := errors.New("handler failed")
+	errSave := errors.New("connection lost")
+
+	tests := []struct {
+		name string
+		// first is the function of the first call; the second call, with the
+		// same key, may run fn again only if rerun is set.
+		first     func(ctx context.Context) ([]byte, error)
+		saveErr   error
+		firstErr  error
+		rerun     bool
+		secondErr error
+		secondRes string
+	}{
+		{
+			name:      "replays the stored response",
+			first:     func(ctx context.Context) ([]byte, error) { return []byte("first"), commit(ctx) },
+			secondRes: "first",
+		},
+		{
+			name:      "releases the key when nothing was committed",
+			first:     func(context.Context) ([]byte, error) { return nil, errHandler },
+			firstErr:  errHandler,
+			rerun:     true,
+			secondRes: "second",
+		},
+		{
+			name: "keeps the key when the handler failed after committing",
+			first: func(ctx context.Context) ([]byte, error) {
+				if err := commit(ctx); err != nil {
+					return nil, err
+				}
+				return nil, errHandler
+			},
+			firstErr:  errHandler,
+			secondErr: entity.ErrFailedPrecondition,
+		},
+		{
+			name:      "does not run again when the response was not saved",
+			first:     func(ctx context.Context) ([]byte, error) { return []byte("first"), commit(ctx) },
+			saveErr:   errSave,
+			firstErr:  errSave,
+			secondErr: entity.ErrFailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeRepository()
+			repo.saveErr = tt.saveErr
+			uc := NewUseCase(repo, fakeTransactor{})
+			ctx := context.Background()
+			hash := []byte("hash")
+
+			_, err := uc.Do(ctx, "user", "key", "/order.OrderService/CreateOrder", hash, tt.first)
+			if !errors.Is(err, tt.firstErr) {
+				t.Fatalf("first Do() error = %v, want %v", err, tt.firstErr)
+			}
+
+			repo.saveErr = nil
+			reran := false
+			res, err := uc.Do(ctx, "user", "key", "/order.OrderService/CreateOrder", hash, func(ctx context.Context) ([]byte, error) {
+				reran = true
+				return []byte("second"), commit(ctx)
+			})
+			if !errors.Is(err, tt.secondErr) {
+				t.Fatalf("second Do() error = %v, want %v", err, tt.secondErr)
+			}
+			if reran != tt.rerun {
+				t.Errorf("second Do() ran the handler: %v, want %v", reran, tt.rerun)
+			}
+			if string(res) != tt.secondRes {
+				t.Errorf("second Do() = %q, want %q", res, tt.secondRes)
+			}
+		})
+	}
+}
+
+func TestDoRejectsAnotherRequest(t *testing.T) {
+	uc := NewUseCase(newFakeRepository(), fakeTransactor{})
+	ctx := context.Background()
+	fn := func(ctx context.Context) ([]byte, error) { return []byte("ok"), commit(ctx) }
+
+	if _, err := uc.Do(ctx, "user", "key", "method", []byte("first"), fn); err != nil {
+		t.Fatalf("first Do() error = %v", err)
+	}
+	if _, err := uc.Do(ctx, "user", "key", "method", []byte("second"), fn); !errors.Is(err, entity.ErrInvalidArgument) {
+		t.Fatalf("Do() with another request error = %v, want %v", err, entity.ErrInvalidArgument)
+	}
+	// Keys of other users do not collide.
+	if _, err := uc.Do(ctx, "other", "key", "method", []byte("second"), fn); err != nil {
+		t.Fatalf("Do() of another user error = %v", err)
+	}
+}
@@ -0,0 +1,187 @@
+package kitchen
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Tortik3000/service-order/internal/domain/entity"
+)
+
+// queueStatuses are the statuses of orders shown in the kitchen queue.
+var queueStatuses = []entity.OrderStatus{
+	entity.OrderStatusPaid,
+	entity.OrderStatusInProgress,
+}
+
+type Usecase interface {
+	GetQueue(ctx context.Context, placeID string) ([]entity.KitchenTicket, error)
+	StartPreparing(ctx context.Context, orderID, changedBy string) (*entity.KitchenTicket, error)
+	MarkReady(ctx context.Context, orderID, changedBy string) (*entity.KitchenTicket, error)
+	WatchQueue(ctx context.Context, placeID string, send func([]entity.KitchenTicket) error) error
+}
+
+type (
+	orderRepository interface {
+		ListByPlace(ctx context.Context, placeID string, statuses []entity.OrderStatus) ([]entity.Order, error)
+	}
+
+	menuRepository interface {
+		GetMenuItemsByIDs(ctx context.Context, ids []string) ([]entity.MenuItem, error)
+	}
+
+	placeRepository interface {
+		Get(ctx context.Context, id string) (*entity.Place, error)
+	}
+
+	orderUseCase interface {
+		UpdateOrderStatus(ctx context.Context, id string, status entity.OrderStatus, reason, changedBy string) (*entity.Order, error)
+	}
+
+	queueWatcher interface {
+		SubscribePlace(placeID string) (<-chan struct{}, func())
+	}
+)
+
+type useCase struct {
+	orderRepo orderRepository
+	menuRepo  menuRepository
+	placeRepo placeRepository
+	orderUC   orderUseCase
+	watcher   queueWatcher
+}
+
+var _ Usecase = (*useCase)(nil)
+
+func NewUseCase(
+	orderRepo orderRepository,
+	menuRepo menuRepository,
+	placeRepo placeRepository,
+	orderUC orderUseCase,
+	watcher queueWatcher,
+) *useCase {
+	return &useCase{
+		orderRepo: orderRepo,
+		menuRepo:  menuRepo,
+		placeRepo: placeRepo,
+		orderUC:   orderUC,
+		watcher:   watcher,
+	}
+}
+
+// GetQueue returns the paid and in-progress orders of the place, oldest
+// first.
+func (u *useCase) GetQueue(ctx context.Context, placeID string) ([]entity.KitchenTicket, error) {
+	if _, err := u.placeRepo.Get(ctx, placeID); err != nil {
+		return nil, fmt.Errorf("get place: %w", err)
+	}
+
+	return u.queue(ctx, placeID)
+}
+
+// StartPreparing moves a paid order to IN_PROGRESS.
+func (u *useCase) StartPreparing(ctx context.Context, orderID, changedBy string) (*entity.KitchenTicket, error) {
+	return u.moveTo(ctx, orderID, entity.OrderStatusInProgress, changedBy)
+}
+
+// MarkReady moves an order in progress to READY, which removes it from the
+// queue.
+func (u *useCase) MarkReady(ctx context.Context, orderID, changedBy string) (*entity.KitchenTicket, error) {
+	return u.moveTo(ctx, orderID, entity.OrderStatusReady, changedBy)
+}
+
+// WatchQueue sends the queue of the place and then the whole queue again
+// after every status change of one of its orders, until ctx is cancelled.
+func (u *useCase) WatchQueue(ctx context.Context, placeID string, send func([]entity.KitchenTicket) error) error {
+	if _, err := u.placeRepo.Get(ctx, placeID); err != nil {
+		return fmt.Errorf("get place: %w", err)
+	}
+
+	// Subscribe before reading the queue, so that a change made in between
+	// is not missed.
+	changes, unsubscribe := u.watcher.SubscribePlace(placeID)
+	defer unsubscribe()
+
+	for {
+		tickets, err := u.queue(ctx, placeID)
+		if err != nil {
+			return err
+		}
+		if err := send(tickets); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-changes:
+		}
+	}
+}
+
+func (u *useCase) moveTo(ctx context.Context, orderID string, status entity.OrderStatus, changedBy string) (*entity.KitchenTicket, error) {
+	// The order usecase checks the transition and records the history and
+	// the order event, exactly as for any other status change.
+	order, err := u.orderUC.UpdateOrderStatus(ctx, orderID, status, "", changedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	tickets, err := u.tickets(ctx, []entity.Order{*order})
+	if err != nil {
+		return nil, err
+	}
+
+	return &tickets[0], nil
+}
+
+func (u *useCase) queue(ctx context.Context, placeID string) ([]entity.KitchenTicket, error) {
+	orders, err := u.orderRepo.ListByPlace(ctx, placeID, queueStatuses)
+	if err != nil {
+		return nil, fmt.Errorf("list orders by place: %w", err)
+	}
+
+	return u.tickets(ctx, orders)
+}
+
+// tickets resolves the menu item names of all orders with a single lookup.
+func (u *useCase) tickets(ctx context.Context, orders []entity.Order) ([]entity.KitchenTicket, error) {
+	var ids []string
+	seen := make(map[string]bool)
+	for _, order := range orders {
+		for _, item := range order.Items {
+			if !seen[item.MenuItemID] {
+				seen[item.MenuItemID] = true
+				ids = append(ids, item.MenuItemID)
+			}
+		}
+	}
+
+	names := make(map[string]string, len(ids))
+	if len(ids) > 0 {
+		menuItems, err := u.menuRepo.GetMenuItemsByIDs(ctx, ids)
+		if err != nil {
+			return nil, fmt.Errorf("get menu items: %w", err)
+		}
+		for _, menuItem := range menuItems {
+			names[menuItem.ID] = menuItem.Name
+		}
+	}
+
+	tickets := make([]entity.KitchenTicket, len(orders))
+	for i, order := range orders {
+		items := make([]entity.KitchenTicketItem, len(order.Items))
+		for j, item := range order.Items {
+			items[j] = entity.KitchenTicketItem{
+				MenuItemID: item.MenuItemID,
+				Name:       names[item.MenuItemID],
+				Quantity:   item.Quantity,
+			}
+		}
+		tickets[i] = entity.KitchenTicket{
+			Order: order,
+			Items: items,
+		}
+	}
+
+	return tickets, nil
+}
@@ -2,18 +2,38 @@ package order
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
 
 	"github.com/Tortik3000/service-order/internal/domain/entity"
 )
 
+// eventsTopic is the Kafka topic order events are published to.
+const eventsTopic = "order-events"
+
+var (
+	// serializable is used where checks read rows that a concurrent
+	// transaction may change; the transactor retries the one Postgres aborts.
+	serializable = pgx.TxOptions{IsoLevel: pgx.Serializable}
+	// readOnlySnapshot is used for reads that must agree with each other,
+	// such as a page of results and their total count.
+	readOnlySnapshot = pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly}
+)
+
 type Usecase interface {
-	CreateOrder(ctx context.Context, userID, restaurantID string, items []entity.OrderItem, pickUp bool) (*entity.Order, error)
+	CreateOrder(ctx context.Context, userID, restaurantID string, items []entity.OrderItem, pickUp bool, pickupTime time.Time) (*entity.Order, error)
 	GetOrder(ctx context.Context, id string) (*entity.Order, error)
-	ListUserOrders(ctx context.Context, userID string, limit, offset int32) ([]entity.Order, error)
-	ListOrdersByStatus(ctx context.Context, statuses []entity.OrderStatus, limit, offset int32) ([]entity.Order, error)
-	UpdateOrderStatus(ctx context.Context, id string, status entity.OrderStatus) (*entity.Order, error)
-	CancelOrder(ctx context.Context, id string, reason string) (*entity.Order, error)
+	ListUserOrders(ctx context.Context, userID string, pageSize int32, pageToken string, view entity.OrderView) ([]entity.Order, string, error)
+	ListOrdersByStatus(ctx context.Context, statuses []entity.OrderStatus, pageSize int32, pageToken string, view entity.OrderView) ([]entity.Order, string, error)
+	SearchOrders(ctx context.Context, filter entity.OrderFilter, sort entity.OrderSort, limit, offset int32, view entity.OrderView) ([]entity.Order, int64, error)
+	UpdateOrderStatus(ctx context.Context, id string, status entity.OrderStatus, reason, changedBy string) (*entity.Order, error)
+	CancelOrder(ctx context.Context, id string, reason, changedBy string) (*entity.Order, error)
+	GetOrderHistory(ctx context.Context, id string) ([]entity.OrderStatusChange, error)
+	WatchOrder(ctx context.Context, id string, send func(*entity.Order) error) error
 }
 
 type (
@@ -21,23 +41,48 @@ type (
 		Create(ctx context.Context, order *entity.Order) error
 		CreateItems(ctx context.Context, orderID string, items []entity.OrderItem) error
 		Get(ctx context.Context, id string) (*entity.Order, error)
-		UpdateStatus(ctx context.Context, id string, status entity.OrderStatus) error
-		ListByUser(ctx context.Context, userID string, limit, offset int32) ([]entity.Order, error)
-		ListByStatus(ctx context.Context, statuses []entity.OrderStatus, limit, offset int32) ([]entity.Order, error)
+		UpdateStatus(ctx context.Context, id string, from, to entity.OrderStatus) error
+		ListByUser(ctx context.Context, userID string, after *entity.OrderCursor, limit int32, view entity.OrderView) ([]entity.Order, *entity.OrderCursor, error)
+		ListByStatus(ctx context.Context, statuses []entity.OrderStatus, after *entity.OrderCursor, limit int32, view entity.OrderView) ([]entity.Order, *entity.OrderCursor, error)
+		Search(ctx context.Context, filter entity.OrderFilter, sort entity.OrderSort, limit, offset int32, view entity.OrderView) ([]entity.Order, int64, error)
+		CountInPickupRange(ctx context.Context, placeID string, from, to time.Time) (int32, error)
+		AddStatusChange(ctx context.Context, change *entity.OrderStatusChange) error
+		ListStatusChanges(ctx context.Context, orderID string) ([]entity.OrderStatusChange, error)
 	}
 
 	menuRepository interface {
-		GetMenuItem(ctx context.Context, id string) (*entity.MenuItem, error)
+		GetMenuItemsByIDs(ctx context.Context, ids []string) ([]entity.MenuItem, error)
+	}
+
+	placeRepository interface {
+		GetForUpdate(ctx context.Context, id string) (*entity.Place, error)
+	}
+
+	outboxRepository interface {
+		Add(ctx context.Context, msg *entity.OutboxMessage) error
+	}
+
+	statusWatcher interface {
+		Subscribe(orderID string) (<-chan struct{}, func())
+	}
+
+	phoneParser interface {
+		Normalize(number string) (string, error)
 	}
 
 	txManager interface {
 		WithTx(ctx context.Context, function func(ctx context.Context) error) error
+		WithTxOptions(ctx context.Context, options pgx.TxOptions, function func(ctx context.Context) error) error
 	}
 )
 
 type useCase struct {
 	orderRepo  orderRepository
 	menuRepo   menuRepository
+	placeRepo  placeRepository
+	outboxRepo outboxRepository
+	watcher    statusWatcher
+	phones     phoneParser
 	transactor txManager
 }
 
@@ -46,24 +91,54 @@ var _ Usecase = (*useCase)(nil)
 func NewUseCase(
 	orderRepo orderRepository,
 	menuRepo menuRepository,
+	placeRepo placeRepository,
+	outboxRepo outboxRepository,
+	watcher statusWatcher,
+	phones phoneParser,
 	transactor txManager,
 ) *useCase {
 	return &useCase{
 		orderRepo:  orderRepo,
 		menuRepo:   menuRepo,
+		placeRepo:  placeRepo,
+		outboxRepo: outboxRepo,
+		watcher:    watcher,
+		phones:     phones,
 		transactor: transactor,
 	}
 }
 
-func (u *useCase) CreateOrder(ctx context.Context, userID, restaurantID string, items []entity.OrderItem, pickUp bool) (*entity.Order, error) {
-	var totalAmount int64
+func (u *useCase) CreateOrder(ctx context.Context, userID, restaurantID string, items []entity.OrderItem, pickUp bool, pickupTime time.Time) (*entity.Order, error) {
+	if err := entity.CheckUserAccess(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	items = mergeItems(items)
+
+	ids := make([]string, len(items))
+	for i, item := range items {
+		ids[i] = item.MenuItemID
+	}
+	menuItems, err := u.menuRepo.GetMenuItemsByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("get menu items: %w", err)
+	}
+	menuItemsByID := make(map[string]entity.MenuItem, len(menuItems))
+	for _, menuItem := range menuItems {
+		menuItemsByID[menuItem.ID] = menuItem
+	}
+	if err := checkItems(items, menuItemsByID); err != nil {
+		return nil, err
+	}
+
+	var totalAmount entity.Money
 	for i, item := range items {
-		menuItem, err := u.menuRepo.GetMenuItem(ctx, item.MenuItemID)
+		menuItem := menuItemsByID[item.MenuItemID]
+		items[i].UnitPrice = menuItem.Price
+		totalAmount, err = totalAmount.Add(menuItem.Price.Multiply(int64(item.Quantity)))
 		if err != nil {
-			return nil, fmt.Errorf("get menu item %s: %w", item.MenuItemID, err)
+			return nil, err
 		}
-		items[i].UnitPrice = int64(menuItem.Price * 100) // Сохраняем в копейках/центах
-		totalAmount += items[i].UnitPrice * int64(item.Quantity)
 	}
 
 	order := &entity.Order{
@@ -73,9 +148,31 @@ func (u *useCase) CreateOrder(ctx context.Context, userID, restaurantID string,
 		TotalAmount:  totalAmount,
 		Items:        items,
 		PickUp:       pickUp,
+		PickupTime:   pickupTime,
 	}
 
-	err := u.transactor.WithTx(ctx, func(ctx context.Context) error {
+	err = u.transactor.WithTxOptions(ctx, serializable, func(ctx context.Context) error {
+		// The place row stays locked until commit, so concurrent orders for
+		// the same place cannot both take the last spot in a pickup slot.
+		place, err := u.placeRepo.GetForUpdate(ctx, restaurantID)
+		if err != nil {
+			if errors.Is(err, entity.ErrNotFound) {
+				return entity.NewInvalidArgumentError("PLACE_NOT_FOUND", "place not found").
+					WithViolation("restaurant_id", "place does not exist")
+			}
+			return fmt.Errorf("get place: %w", err)
+		}
+		if !place.Active {
+			return entity.NewFailedPreconditionError("PLACE_INACTIVE", "place does not accept orders").
+				WithMetadata("place_id", place.ID)
+		}
+
+		if !pickupTime.IsZero() {
+			if err := u.checkPickupSlot(ctx, place, pickupTime); err != nil {
+				return err
+			}
+		}
+
 		if err := u.orderRepo.Create(ctx, order); err != nil {
 			return fmt.Errorf("create order: %w", err)
 		}
@@ -84,7 +181,21 @@ func (u *useCase) CreateOrder(ctx context.Context, userID, restaurantID string,
 			return fmt.Errorf("create order items: %w", err)
 		}
 
-		return nil
+		err = u.orderRepo.AddStatusChange(ctx, &entity.OrderStatusChange{
+			OrderID:   order.ID,
+			From:      entity.OrderStatusUnspecified,
+			To:        order.Status,
+			ChangedBy: userID,
+		})
+		if err != nil {
+			return fmt.Errorf("add order status change: %w", err)
+		}
+
+		return u.addEvent(ctx, entity.OrderEvent{
+			Type:      entity.OrderEventCreated,
+			Status:    order.Status.String(),
+			ChangedBy: userID,
+		}, order)
 	})
 	if err != nil {
 		return nil, err
@@ -93,26 +204,333 @@ func (u *useCase) CreateOrder(ctx context.Context, userID, restaurantID string,
 	return order, nil
 }
 
+// mergeItems combines lines that refer to the same menu item, keeping the
+// position of the first one.
+func mergeItems(items []entity.OrderItem) []entity.OrderItem {
+	merged := make([]entity.OrderItem, 0, len(items))
+	positions := make(map[string]int, len(items))
+	for _, item := range items {
+		if i, ok := positions[item.MenuItemID]; ok {
+			merged[i].Quantity += item.Quantity
+			continue
+		}
+		positions[item.MenuItemID] = len(merged)
+		merged = append(merged, item)
+	}
+	return merged
+}
+
+// checkItems verifies that every line refers to an existing, active menu
+// item and, when the client sent a unit price, that it is still current.
+func checkItems(items []entity.OrderItem, menuItems map[string]entity.MenuItem) error {
+	var notFound, inactive, priceChanged *entity.Error
+	for _, item := range items {
+		menuItem, ok := menuItems[item.MenuItemID]
+		switch {
+		case !ok:
+			if notFound == nil {
+				notFound = entity.NewInvalidArgumentError("MENU_ITEM_NOT_FOUND", "some menu items do not exist")
+			}
+			notFound.WithViolation("items.menu_item_id", fmt.Sprintf("menu item %s does not exist", item.MenuItemID))
+		case !menuItem.Active:
+			if inactive == nil {
+				inactive = entity.NewFailedPreconditionError("MENU_ITEM_INACTIVE", "some menu items are not available")
+			}
+			inactive.WithViolation(item.MenuItemID, fmt.Sprintf("%s is not available", menuItem.Name))
+		case item.UnitPrice.Currency != "" && item.UnitPrice != menuItem.Price:
+			if priceChanged == nil {
+				priceChanged = entity.NewFailedPreconditionError("PRICE_CHANGED", "prices of some menu items have changed")
+			}
+			priceChanged.WithViolation(item.MenuItemID, fmt.Sprintf(
+				"unit price changed from %d %s to %d %s",
+				item.UnitPrice.Amount, item.UnitPrice.Currency,
+				menuItem.Price.Amount, menuItem.Price.Currency,
+			))
+		}
+	}
+
+	switch {
+	case notFound != nil:
+		return notFound
+	case inactive != nil:
+		return inactive
+	case priceChanged != nil:
+		return priceChanged
+	}
+	return nil
+}
+
+func (u *useCase) checkPickupSlot(ctx context.Context, place *entity.Place, pickupTime time.Time) error {
+	if !pickupTime.After(time.Now()) {
+		return entity.NewFailedPreconditionError("PICKUP_SLOT_IN_PAST", "pickup time is in the past").
+			WithViolation("pickup_time", "pickup time must be in the future")
+	}
+
+	slot, ok, err := place.PickupSlotAt(pickupTime)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return entity.NewInvalidArgumentError("INVALID_PICKUP_SLOT", "pickup time does not match any pickup slot").
+			WithViolation("pickup_time", "pickup time must be the start of a pickup slot of the place")
+	}
+
+	// Orders scheduled before the place changed its slots may fall anywhere
+	// in the slot, so the whole range is counted.
+	count, err := u.orderRepo.CountInPickupRange(ctx, place.ID, slot.Start, slot.End)
+	if err != nil {
+		return fmt.Errorf("count orders in pickup slot: %w", err)
+	}
+	if count >= slot.Capacity {
+		return entity.NewFailedPreconditionError("PICKUP_SLOT_FULL", "pickup slot is full").
+			WithViolation("pickup_time", "no capacity left in the pickup slot")
+	}
+
+	return nil
+}
+
+// addEvent fills event with the state of order and stores it in the outbox.
+// It must run in the transaction that changed the order, so the event is
+// published if and only if the change is committed.
+func (u *useCase) addEvent(ctx context.Context, event entity.OrderEvent, order *entity.Order) error {
+	event.OrderID = order.ID
+	event.UserID = order.UserID
+	event.RestaurantID = order.RestaurantID
+	event.TotalAmount = order.TotalAmount.Amount
+	event.Currency = order.TotalAmount.Currency
+	event.OccurredAt = time.Now().UTC()
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal order event: %w", err)
+	}
+
+	err = u.outboxRepo.Add(ctx, &entity.OutboxMessage{
+		Topic:     eventsTopic,
+		Key:       order.ID,
+		EventType: event.Type,
+		Payload:   payload,
+	})
+	if err != nil {
+		return fmt.Errorf("add order event to outbox: %w", err)
+	}
+
+	return nil
+}
+
+// GetOrder returns the order if the caller may see it: customers only see
+// their own orders.
 func (u *useCase) GetOrder(ctx context.Context, id string) (*entity.Order, error) {
-	return u.orderRepo.Get(ctx, id)
+	order, err := u.orderRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := entity.CheckUserAccess(ctx, order.UserID); err != nil {
+		return nil, err
+	}
+	return order, nil
 }
 
-func (u *useCase) ListUserOrders(ctx context.Context, userID string, limit, offset int32) ([]entity.Order, error) {
-	return u.orderRepo.ListByUser(ctx, userID, limit, offset)
+// ListUserOrders returns a page of the user's orders, newest first, and the
+// token of the next page, which is empty on the last page.
+func (u *useCase) ListUserOrders(ctx context.Context, userID string, size int32, token string, view entity.OrderView) ([]entity.Order, string, error) {
+	if err := entity.CheckUserAccess(ctx, userID); err != nil {
+		return nil, "", err
+	}
+
+	after, err := decodePageToken(token)
+	if err != nil {
+		return nil, "", err
+	}
+
+	orders, next, err := u.orderRepo.ListByUser(ctx, userID, after, pageSize(size), view)
+	if err != nil {
+		return nil, "", fmt.Errorf("list orders by user: %w", err)
+	}
+
+	return orders, encodePageToken(next), nil
+}
+
+// ListOrdersByStatus is like ListUserOrders, but for orders in any of the
+// statuses.
+func (u *useCase) ListOrdersByStatus(ctx context.Context, statuses []entity.OrderStatus, size int32, token string, view entity.OrderView) ([]entity.Order, string, error) {
+	after, err := decodePageToken(token)
+	if err != nil {
+		return nil, "", err
+	}
+
+	orders, next, err := u.orderRepo.ListByStatus(ctx, statuses, after, pageSize(size), view)
+	if err != nil {
+		return nil, "", fmt.Errorf("list orders by status: %w", err)
+	}
+
+	return orders, encodePageToken(next), nil
+}
+
+// SearchOrders returns a page of the orders matching filter, sorted by sort,
+// and the number of all matching orders.
+func (u *useCase) SearchOrders(ctx context.Context, filter entity.OrderFilter, sort entity.OrderSort, limit, offset int32, view entity.OrderView) ([]entity.Order, int64, error) {
+	if err := checkFilter(filter); err != nil {
+		return nil, 0, err
+	}
+	if filter.CustomerPhone != "" {
+		// Customers are stored with E.164 phones.
+		phone, err := u.phones.Normalize(filter.CustomerPhone)
+		if err != nil {
+			return nil, 0, entity.NewInvalidArgumentError("INVALID_FILTER", "invalid order filter").
+				WithViolation("filter.customer_phone", "customer_phone must be a valid phone number").
+				WithCause(err)
+		}
+		filter.CustomerPhone = phone
+	}
+
+	var (
+		orders []entity.Order
+		total  int64
+	)
+	err := u.transactor.WithTxOptions(ctx, readOnlySnapshot, func(ctx context.Context) error {
+		var err error
+		orders, total, err = u.orderRepo.Search(ctx, filter, sort, pageSize(limit), max(offset, 0), view)
+		if err != nil {
+			return fmt.Errorf("search orders: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return orders, total, nil
 }
 
-func (u *useCase) ListOrdersByStatus(ctx context.Context, statuses []entity.OrderStatus, limit, offset int32) ([]entity.Order, error) {
-	return u.orderRepo.ListByStatus(ctx, statuses, limit, offset)
+func checkFilter(filter entity.OrderFilter) error {
+	var invalid *entity.Error
+	violation := func(subject, description string) {
+		if invalid == nil {
+			invalid = entity.NewInvalidArgumentError("INVALID_FILTER", "invalid order filter")
+		}
+		invalid.WithViolation(subject, description)
+	}
+
+	if !filter.CreatedFrom.IsZero() && !filter.CreatedTo.IsZero() && !filter.CreatedFrom.Before(filter.CreatedTo) {
+		violation("filter.created_to", "created_to must be after created_from")
+	}
+	if filter.MinTotalAmount != nil && filter.MaxTotalAmount != nil && *filter.MinTotalAmount > *filter.MaxTotalAmount {
+		violation("filter.max_total_amount", "max_total_amount must not be less than min_total_amount")
+	}
+
+	if invalid != nil {
+		return invalid
+	}
+	return nil
 }
 
-func (u *useCase) UpdateOrderStatus(ctx context.Context, id string, status entity.OrderStatus) (*entity.Order, error) {
-	if err := u.orderRepo.UpdateStatus(ctx, id, status); err != nil {
+func (u *useCase) UpdateOrderStatus(ctx context.Context, id string, status entity.OrderStatus, reason, changedBy string) (*entity.Order, error) {
+	var order *entity.Order
+	err := u.transactor.WithTx(ctx, func(ctx context.Context) error {
+		current, err := u.orderRepo.Get(ctx, id)
+		if err != nil {
+			return fmt.Errorf("get order: %w", err)
+		}
+		if !current.Status.CanTransitionTo(status) {
+			return entity.NewStatusTransitionError(current.Status, status)
+		}
+
+		// The update is conditional on the status we have just checked, so a
+		// concurrent transition makes it fail instead of being overwritten.
+		if err := u.orderRepo.UpdateStatus(ctx, id, current.Status, status); err != nil {
+			return fmt.Errorf("update order status: %w", err)
+		}
+
+		err = u.orderRepo.AddStatusChange(ctx, &entity.OrderStatusChange{
+			OrderID:   id,
+			From:      current.Status,
+			To:        status,
+			Reason:    reason,
+			ChangedBy: changedBy,
+		})
+		if err != nil {
+			return fmt.Errorf("add order status change: %w", err)
+		}
+
+		order, err = u.orderRepo.Get(ctx, id)
+		if err != nil {
+			return fmt.Errorf("get order: %w", err)
+		}
+
+		eventType := entity.OrderEventStatusChanged
+		if status == entity.OrderStatusCancelled {
+			eventType = entity.OrderEventCancelled
+		}
+		return u.addEvent(ctx, entity.OrderEvent{
+			Type:       eventType,
+			FromStatus: current.Status.String(),
+			Status:     status.String(),
+			Reason:     reason,
+			ChangedBy:  changedBy,
+		}, order)
+	})
+	if err != nil {
 		return nil, err
 	}
-	return u.orderRepo.Get(ctx, id)
+
+	return order, nil
 }
 
-func (u *useCase) CancelOrder(ctx context.Context, id string, reason string) (*entity.Order, error) {
+func (u *useCase) CancelOrder(ctx context.Context, id string, reason, changedBy string) (*entity.Order, error) {
+	if _, err := u.GetOrder(ctx, id); err != nil {
+		return nil, err
+	}
+
 	// В реальном приложении здесь могла бы быть логика отмены платежа
-	return u.UpdateOrderStatus(ctx, id, entity.OrderStatusCancelled)
+	return u.UpdateOrderStatus(ctx, id, entity.OrderStatusCancelled, reason, changedBy)
+}
+
+func (u *useCase) GetOrderHistory(ctx context.Context, id string) ([]entity.OrderStatusChange, error) {
+	if _, err := u.GetOrder(ctx, id); err != nil {
+		return nil, fmt.Errorf("get order: %w", err)
+	}
+
+	return u.orderRepo.ListStatusChanges(ctx, id)
+}
+
+// WatchOrder sends the current state of the order and then the order after
+// every status change, until the order reaches a terminal status or ctx is
+// cancelled.
+func (u *useCase) WatchOrder(ctx context.Context, id string, send func(*entity.Order) error) error {
+	// Subscribe before reading the order, so that a change made in between
+	// is not missed.
+	changes, unsubscribe := u.watcher.Subscribe(id)
+	defer unsubscribe()
+
+	order, err := u.GetOrder(ctx, id)
+	if err != nil {
+		return fmt.Errorf("get order: %w", err)
+	}
+	if err := send(order); err != nil {
+		return err
+	}
+
+	for !order.Status.IsTerminal() {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-changes:
+		}
+
+		current, err := u.orderRepo.Get(ctx, id)
+		if err != nil {
+			return fmt.Errorf("get order: %w", err)
+		}
+		if current.Status == order.Status {
+			continue
+		}
+
+		order = current
+		if err := send(order); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
@@ -0,0 +1,67 @@
+package order
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/Tortik3000/service-order/internal/domain/entity"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// pageToken is the content of the opaque page tokens handed to clients.
+type pageToken struct {
+	CreatedAt int64  `json:"c"` // Unix microseconds, the precision of Postgres timestamps
+	ID        string `json:"i"`
+}
+
+// pageSize returns the number of orders to list for a requested page size:
+// the default when it is not set and at most maxPageSize.
+func pageSize(requested int32) int32 {
+	if requested <= 0 {
+		return defaultPageSize
+	}
+	return min(requested, maxPageSize)
+}
+
+func encodePageToken(cursor *entity.OrderCursor) string {
+	if cursor == nil {
+		return ""
+	}
+
+	data, _ := json.Marshal(pageToken{
+		CreatedAt: cursor.CreatedAt.UnixMicro(),
+		ID:        cursor.ID,
+	})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodePageToken returns nil for an empty token, which means the first page.
+func decodePageToken(token string) (*entity.OrderCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, invalidPageTokenError()
+	}
+	var decoded pageToken
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded.ID == "" {
+		return nil, invalidPageTokenError()
+	}
+
+	return &entity.OrderCursor{
+		CreatedAt: time.UnixMicro(decoded.CreatedAt),
+		ID:        decoded.ID,
+	}, nil
+}
+
+func invalidPageTokenError() error {
+	return entity.NewInvalidArgumentError("INVALID_PAGE_TOKEN", "invalid page token").
+		WithViolation("page_token", "page token must be a next_page_token returned by a previous call")
+}
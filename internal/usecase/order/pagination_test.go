@@ -0,0 +1,152 @@
+package order
+
+import (
+	"cmp"
+	"context"
+	"encoding/base64"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Tortik3000/service-order/internal/domain/entity"
+)
+
+func TestPageTokenRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor *entity.OrderCursor
+	}{
+		{name: "first page", cursor: nil},
+		{
+			name:   "whole second",
+			cursor: &entity.OrderCursor{CreatedAt: time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC), ID: "7d0c8a4e-3b0f-4f7e-9a43-6a1d2b9c1e01"},
+		},
+		{
+			name:   "microseconds",
+			cursor: &entity.OrderCursor{CreatedAt: time.Date(2026, 10, 18, 12, 0, 0, 123456000, time.UTC), ID: "id"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := encodePageToken(tt.cursor)
+			if (token == "") != (tt.cursor == nil) {
+				t.Fatalf("encodePageToken() = %q", token)
+			}
+
+			got, err := decodePageToken(token)
+			if err != nil {
+				t.Fatalf("decodePageToken() error = %v", err)
+			}
+			if tt.cursor == nil {
+				if got != nil {
+					t.Errorf("decodePageToken() = %+v, want nil", got)
+				}
+				return
+			}
+			if got == nil || !got.CreatedAt.Equal(tt.cursor.CreatedAt) || got.ID != tt.cursor.ID {
+				t.Errorf("decodePageToken() = %+v, want %+v", got, tt.cursor)
+			}
+		})
+	}
+}
+
+func TestDecodePageTokenInvalid(t *testing.T) {
+	valid := encodePageToken(&entity.OrderCursor{CreatedAt: time.Now(), ID: "id"})
+	encode := func(s string) string { return base64.RawURLEncoding.EncodeToString([]byte(s)) }
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "not base64", token: "not a token!"},
+		{name: "padded base64", token: base64.URLEncoding.EncodeToString([]byte(`{"c":1,"i":"id"}`))},
+		{name: "not json", token: encode("garbage")},
+		{name: "missing id", token: encode(`{"c":1}`)},
+		{name: "wrong types", token: encode(`{"c":"yesterday","i":1}`)},
+		{name: "truncated", token: valid[:len(valid)-3]},
+		{name: "tampered", token: valid[:len(valid)-1] + "*"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor, err := decodePageToken(tt.token)
+			if !errors.Is(err, entity.ErrInvalidArgument) {
+				t.Fatalf("decodePageToken(%q) = %+v, %v, want an invalid argument error", tt.token, cursor, err)
+			}
+		})
+	}
+}
+
+// keysetRepository lists orders newest first by creation time and then ID,
+// like the Postgres repository does.
+type keysetRepository struct {
+	orderRepository
+	orders    []entity.Order
+	createdAt map[string]time.Time
+}
+
+func (r *keysetRepository) ListByStatus(_ context.Context, _ []entity.OrderStatus, after *entity.OrderCursor, limit int32, _ entity.OrderView) ([]entity.Order, *entity.OrderCursor, error) {
+	sorted := slices.Clone(r.orders)
+	slices.SortFunc(sorted, func(a, b entity.Order) int {
+		if c := r.createdAt[b.ID].Compare(r.createdAt[a.ID]); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.ID, a.ID)
+	})
+
+	var page []entity.Order
+	for _, order := range sorted {
+		createdAt := r.createdAt[order.ID]
+		if after != nil && !(createdAt.Before(after.CreatedAt) || createdAt.Equal(after.CreatedAt) && order.ID < after.ID) {
+			continue
+		}
+		if int32(len(page)) == limit {
+			last := page[len(page)-1]
+			return page, &entity.OrderCursor{CreatedAt: r.createdAt[last.ID], ID: last.ID}, nil
+		}
+		page = append(page, order)
+	}
+	return page, nil, nil
+}
+
+func TestListOrdersPagesThroughTies(t *testing.T) {
+	tie := time.Date(2026, 10, 18, 12, 0, 0, 500001000, time.UTC)
+	repo := &keysetRepository{createdAt: map[string]time.Time{
+		"a": tie.Add(time.Microsecond),
+		"b": tie,
+		"c": tie,
+		"d": tie,
+		"e": tie.Add(-time.Microsecond),
+	}}
+	for id := range repo.createdAt {
+		repo.orders = append(repo.orders, entity.Order{ID: id})
+	}
+	uc := NewUseCase(repo, nil, nil, nil, nil, nil, nil)
+
+	// With two orders a page, the tie on created_at spans the first page
+	// boundary and the second one.
+	var got []string
+	token := ""
+	for page := 0; ; page++ {
+		if page > len(repo.orders) {
+			t.Fatalf("more pages than orders, listed %v", got)
+		}
+		orders, next, err := uc.ListOrdersByStatus(context.Background(), nil, 2, token, entity.OrderViewBasic)
+		if err != nil {
+			t.Fatalf("ListOrdersByStatus() error = %v", err)
+		}
+		for _, order := range orders {
+			got = append(got, order.ID)
+		}
+		if next == "" {
+			break
+		}
+		token = next
+	}
+
+	if want := []string{"a", "d", "c", "b", "e"}; !slices.Equal(got, want) {
+		t.Errorf("listed %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,167 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/Tortik3000/service-order/internal/domain/entity"
+	"github.com/Tortik3000/service-order/pkg/kafka"
+)
+
+const (
+	// eventTypeHeader carries the event type so that consumers can route
+	// messages without decoding the payload.
+	eventTypeHeader = "event_type"
+
+	minBackoff = time.Second
+	maxBackoff = 5 * time.Minute
+
+	// publishTimeout bounds one publish to Kafka. It is shorter than
+	// claimLease, so that no other relay claims the messages while they
+	// are still being published.
+	publishTimeout = 30 * time.Second
+	claimLease     = time.Minute
+
+	// sweepBatchSize is how many published messages are deleted at once, so
+	// that a large backlog does not hold one long transaction.
+	sweepBatchSize = 1000
+)
+
+type Usecase interface {
+	Relay(ctx context.Context, batchSize int32) (int, error)
+	DeletePublished(ctx context.Context, retention time.Duration) (int64, error)
+}
+
+type (
+	outboxRepository interface {
+		ClaimPending(ctx context.Context, limit int32, lease time.Duration) ([]entity.OutboxMessage, error)
+		MarkPublished(ctx context.Context, ids []int64) error
+		MarkFailed(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string) error
+		DeletePublished(ctx context.Context, before time.Time, limit int32) (int64, error)
+	}
+
+	txManager interface {
+		WithTx(ctx context.Context, function func(ctx context.Context) error) error
+	}
+)
+
+type useCase struct {
+	outboxRepo outboxRepository
+	publisher  kafka.Producer
+	transactor txManager
+}
+
+var _ Usecase = (*useCase)(nil)
+
+func NewUseCase(outboxRepo outboxRepository, publisher kafka.Producer, transactor txManager) *useCase {
+	return &useCase{
+		outboxRepo: outboxRepo,
+		publisher:  publisher,
+		transactor: transactor,
+	}
+}
+
+// Relay publishes one batch of pending outbox messages and returns how many
+// were published. The messages are claimed first and published outside of a
+// transaction, so no rows stay locked during the round trip to Kafka. They
+// are marked as published only after the broker has acknowledged them, so a
+// crash in between publishes them again: the delivery is at-least-once and
+// consumers must deduplicate by order ID and event type. On failure the
+// messages are rescheduled with an exponential backoff; the messages of one
+// key get the same next attempt and newer ones wait for older ones, so the
+// events of an order reach Kafka in order.
+func (u *useCase) Relay(ctx context.Context, batchSize int32) (int, error) {
+	msgs, err := u.outboxRepo.ClaimPending(ctx, batchSize, claimLease)
+	if err != nil {
+		return 0, fmt.Errorf("claim pending outbox messages: %w", err)
+	}
+	if len(msgs) == 0 {
+		return 0, nil
+	}
+
+	batch := make([]kafka.Message, len(msgs))
+	ids := make([]int64, len(msgs))
+	for i, msg := range msgs {
+		batch[i] = kafka.Message{
+			Topic:   msg.Topic,
+			Key:     []byte(msg.Key),
+			Value:   msg.Payload,
+			Headers: map[string]string{eventTypeHeader: msg.EventType},
+		}
+		ids[i] = msg.ID
+	}
+
+	publishCtx, cancel := context.WithTimeout(ctx, publishTimeout)
+	publishErr := u.publisher.Publish(publishCtx, batch...)
+	cancel()
+
+	// The outcome is recorded even if ctx is cancelled meanwhile, otherwise
+	// published messages would wait for the lease and be published again.
+	ctx = context.WithoutCancel(ctx)
+
+	if publishErr != nil {
+		if err := u.reschedule(ctx, msgs, publishErr); err != nil {
+			return 0, fmt.Errorf("publish outbox messages: %w (%v)", publishErr, err)
+		}
+		return 0, fmt.Errorf("publish outbox messages: %w", publishErr)
+	}
+
+	if err := u.outboxRepo.MarkPublished(ctx, ids); err != nil {
+		return 0, fmt.Errorf("mark outbox messages published: %w", err)
+	}
+
+	return len(msgs), nil
+}
+
+// reschedule marks msgs failed. Messages with the same key get the delay of
+// the oldest one, so a newer event is never due before an older one.
+func (u *useCase) reschedule(ctx context.Context, msgs []entity.OutboxMessage, publishErr error) error {
+	now := time.Now()
+	nextAttempts := make(map[string]time.Time, len(msgs))
+	for _, msg := range msgs {
+		if _, ok := nextAttempts[msg.Key]; !ok {
+			nextAttempts[msg.Key] = now.Add(backoff(msg.Attempts))
+		}
+	}
+
+	return u.transactor.WithTx(ctx, func(ctx context.Context) error {
+		for _, msg := range msgs {
+			if err := u.outboxRepo.MarkFailed(ctx, msg.ID, nextAttempts[msg.Key], publishErr.Error()); err != nil {
+				return fmt.Errorf("mark outbox message failed: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// DeletePublished deletes the messages published more than retention ago
+// and returns how many it deleted. Pending messages are never deleted.
+func (u *useCase) DeletePublished(ctx context.Context, retention time.Duration) (int64, error) {
+	before := time.Now().Add(-retention)
+
+	var total int64
+	for {
+		deleted, err := u.outboxRepo.DeletePublished(ctx, before, sweepBatchSize)
+		if err != nil {
+			return total, fmt.Errorf("delete published outbox messages: %w", err)
+		}
+		total += deleted
+		if deleted < sweepBatchSize {
+			return total, nil
+		}
+	}
+}
+
+// backoff returns the delay before the next attempt after the given number
+// of failed ones: it doubles from minBackoff up to maxBackoff and is then
+// randomised between half and the full value, so that failed batches do not
+// retry in step.
+func backoff(attempts int32) time.Duration {
+	d := maxBackoff
+	if attempts < 20 {
+		d = min(minBackoff<<attempts, maxBackoff)
+	}
+	return d/2 + rand.N(d/2+1)
+}
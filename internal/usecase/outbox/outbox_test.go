@@ -0,0 +1,260 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Tortik3000/service-order/internal/domain/entity"
+	"github.com/Tortik3000/service-order/pkg/kafka"
+)
+
+// fakeRepository keeps outbox messages in memory. A message is pending
+// until it is marked published.
+type fakeRepository struct {
+	pending   []entity.OutboxMessage
+	published []int64
+	failed    map[int64]failure
+	claimErr  error
+	lease     time.Duration
+
+	// deletable is how many published messages DeletePublished may still
+	// delete.
+	deletable int64
+	deletes   int
+}
+
+type failure struct {
+	nextAttemptAt time.Time
+	lastError     string
+}
+
+func (r *fakeRepository) ClaimPending(_ context.Context, limit int32, lease time.Duration) ([]entity.OutboxMessage, error) {
+	if r.claimErr != nil {
+		return nil, r.claimErr
+	}
+	r.lease = lease
+	return slices.Clone(r.pending[:min(int(limit), len(r.pending))]), nil
+}
+
+func (r *fakeRepository) MarkPublished(_ context.Context, ids []int64) error {
+	r.published = append(r.published, ids...)
+	r.pending = slices.DeleteFunc(r.pending, func(msg entity.OutboxMessage) bool {
+		return slices.Contains(ids, msg.ID)
+	})
+	return nil
+}
+
+func (r *fakeRepository) MarkFailed(_ context.Context, id int64, nextAttemptAt time.Time, lastError string) error {
+	if r.failed == nil {
+		r.failed = make(map[int64]failure)
+	}
+	r.failed[id] = failure{nextAttemptAt: nextAttemptAt, lastError: lastError}
+	return nil
+}
+
+func (r *fakeRepository) DeletePublished(_ context.Context, _ time.Time, limit int32) (int64, error) {
+	r.deletes++
+	deleted := min(r.deletable, int64(limit))
+	r.deletable -= deleted
+	return deleted, nil
+}
+
+type fakeTransactor struct{}
+
+func (fakeTransactor) WithTx(ctx context.Context, function func(ctx context.Context) error) error {
+	return function(ctx)
+}
+
+func newMessages(n int) []entity.OutboxMessage {
+	msgs := make([]entity.OutboxMessage, n)
+	for i := range msgs {
+		msgs[i] = entity.OutboxMessage{
+			ID:        int64(i + 1),
+			Topic:     "orders",
+			Key:       fmt.Sprintf("order-%d", i+1),
+			EventType: "order.created",
+			Payload:   []byte(`{}`),
+			Attempts:  int32(i),
+		}
+	}
+	return msgs
+}
+
+func TestRelayPublishesPendingMessages(t *testing.T) {
+	repo := &fakeRepository{pending: newMessages(3)}
+	broker := kafka.NewMemoryBroker()
+	uc := NewUseCase(repo, broker, fakeTransactor{})
+
+	published, err := uc.Relay(context.Background(), 2)
+	if err != nil {
+		t.Fatalf("Relay() error = %v", err)
+	}
+	if published != 2 {
+		t.Errorf("Relay() = %d, want 2", published)
+	}
+
+	msgs := broker.Messages("orders")
+	if len(msgs) != 2 {
+		t.Fatalf("broker got %d messages, want 2", len(msgs))
+	}
+	if got := msgs[0]; string(got.Key) != "order-1" || got.Headers[eventTypeHeader] != "order.created" {
+		t.Errorf("published message = %+v, want the key and event type of the outbox message", got)
+	}
+	if !slices.Equal(repo.published, []int64{1, 2}) {
+		t.Errorf("marked published %v, want [1 2]", repo.published)
+	}
+	if len(repo.failed) != 0 {
+		t.Errorf("marked failed %v, want none", repo.failed)
+	}
+	if repo.lease <= publishTimeout {
+		t.Errorf("claimed for %v, want longer than the publish timeout %v", repo.lease, publishTimeout)
+	}
+}
+
+func TestRelayReschedulesOnPublishFailure(t *testing.T) {
+	repo := &fakeRepository{pending: newMessages(2)}
+	broker := kafka.NewMemoryBroker()
+	publishErr := errors.New("broker unavailable")
+	broker.FailWith(publishErr)
+	uc := NewUseCase(repo, broker, fakeTransactor{})
+
+	start := time.Now()
+	published, err := uc.Relay(context.Background(), 10)
+	if !errors.Is(err, publishErr) {
+		t.Fatalf("Relay() error = %v, want %v", err, publishErr)
+	}
+	if published != 0 {
+		t.Errorf("Relay() = %d, want 0", published)
+	}
+	if len(repo.published) != 0 {
+		t.Errorf("marked published %v, want none", repo.published)
+	}
+
+	for _, msg := range newMessages(2) {
+		f, ok := repo.failed[msg.ID]
+		if !ok {
+			t.Fatalf("message %d was not marked failed", msg.ID)
+		}
+		if f.lastError != publishErr.Error() {
+			t.Errorf("message %d last error = %q, want %q", msg.ID, f.lastError, publishErr.Error())
+		}
+		delay := min(minBackoff<<msg.Attempts, maxBackoff)
+		if f.nextAttemptAt.Before(start.Add(delay/2)) || f.nextAttemptAt.After(time.Now().Add(delay)) {
+			t.Errorf("message %d next attempt in %v, want between %v and %v",
+				msg.ID, f.nextAttemptAt.Sub(start), delay/2, delay)
+		}
+	}
+
+	// The messages go out once the broker is back.
+	broker.FailWith(nil)
+	if _, err := uc.Relay(context.Background(), 10); err != nil {
+		t.Fatalf("Relay() after recovery error = %v", err)
+	}
+	if len(broker.Messages("orders")) != 2 {
+		t.Errorf("broker got %d messages after recovery, want 2", len(broker.Messages("orders")))
+	}
+}
+
+func TestRelayReschedulesKeyTogether(t *testing.T) {
+	msgs := newMessages(4)
+	// Two events of one order, the older one after several failures, and
+	// two of another.
+	msgs[0].Key, msgs[0].Attempts = "order-1", 6
+	msgs[1].Key, msgs[1].Attempts = "order-2", 0
+	msgs[2].Key, msgs[2].Attempts = "order-1", 0
+	msgs[3].Key, msgs[3].Attempts = "order-2", 6
+	repo := &fakeRepository{pending: msgs}
+	broker := kafka.NewMemoryBroker()
+	broker.FailWith(errors.New("broker unavailable"))
+	uc := NewUseCase(repo, broker, fakeTransactor{})
+
+	if _, err := uc.Relay(context.Background(), 10); err == nil {
+		t.Fatal("Relay() error = nil, want the publish error")
+	}
+
+	for _, pair := range [][2]int64{{1, 3}, {2, 4}} {
+		older, newer := repo.failed[pair[0]], repo.failed[pair[1]]
+		if !older.nextAttemptAt.Equal(newer.nextAttemptAt) {
+			t.Errorf("messages %d and %d of one key retry at %v and %v, want the same time",
+				pair[0], pair[1], older.nextAttemptAt, newer.nextAttemptAt)
+		}
+	}
+	// The delay follows the oldest message of the key.
+	if delay := repo.failed[1].nextAttemptAt.Sub(time.Now()); delay < min(minBackoff<<6, maxBackoff)/2-time.Second {
+		t.Errorf("order-1 retries in %v, want the backoff of its oldest message", delay)
+	}
+}
+
+// deadlineProducer records the deadline of the context it publishes with.
+type deadlineProducer struct {
+	*kafka.MemoryBroker
+	deadline time.Time
+}
+
+func (p *deadlineProducer) Publish(ctx context.Context, msgs ...kafka.Message) error {
+	p.deadline, _ = ctx.Deadline()
+	return p.MemoryBroker.Publish(ctx, msgs...)
+}
+
+func TestRelayBoundsPublish(t *testing.T) {
+	repo := &fakeRepository{pending: newMessages(1)}
+	producer := &deadlineProducer{MemoryBroker: kafka.NewMemoryBroker()}
+	uc := NewUseCase(repo, producer, fakeTransactor{})
+
+	start := time.Now()
+	if _, err := uc.Relay(context.Background(), 10); err != nil {
+		t.Fatalf("Relay() error = %v", err)
+	}
+	if producer.deadline.IsZero() || producer.deadline.After(time.Now().Add(publishTimeout)) {
+		t.Fatalf("published with deadline %v, want at most %v from the start", producer.deadline.Sub(start), publishTimeout)
+	}
+}
+
+func TestRelayClaimError(t *testing.T) {
+	claimErr := errors.New("connection lost")
+	repo := &fakeRepository{claimErr: claimErr}
+	broker := kafka.NewMemoryBroker()
+	uc := NewUseCase(repo, broker, fakeTransactor{})
+
+	if _, err := uc.Relay(context.Background(), 10); !errors.Is(err, claimErr) {
+		t.Fatalf("Relay() error = %v, want %v", err, claimErr)
+	}
+	if len(broker.Messages("orders")) != 0 {
+		t.Error("messages were published after a failed claim")
+	}
+}
+
+func TestDeletePublishedInBatches(t *testing.T) {
+	tests := []struct {
+		name        string
+		deletable   int64
+		wantDeletes int
+	}{
+		{name: "nothing", deletable: 0, wantDeletes: 1},
+		{name: "less than a batch", deletable: sweepBatchSize - 1, wantDeletes: 1},
+		{name: "exactly a batch", deletable: sweepBatchSize, wantDeletes: 2},
+		{name: "several batches", deletable: 2*sweepBatchSize + 1, wantDeletes: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeRepository{deletable: tt.deletable}
+			uc := NewUseCase(repo, kafka.NewMemoryBroker(), fakeTransactor{})
+
+			deleted, err := uc.DeletePublished(context.Background(), time.Hour)
+			if err != nil {
+				t.Fatalf("DeletePublished() error = %v", err)
+			}
+			if deleted != tt.deletable {
+				t.Errorf("DeletePublished() = %d, want %d", deleted, tt.deletable)
+			}
+			if repo.deletes != tt.wantDeletes {
+				t.Errorf("repository called %d times, want %d", repo.deletes, tt.wantDeletes)
+			}
+		})
+	}
+}
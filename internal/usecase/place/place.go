@@ -0,0 +1,184 @@
+package place
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Tortik3000/service-order/internal/domain/entity"
+)
+
+// readOnlySnapshot is used for reads that must agree with each other.
+var readOnlySnapshot = pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly}
+
+type Usecase interface {
+	CreatePlace(ctx context.Context, place *entity.Place) error
+	GetPlace(ctx context.Context, id string) (*entity.Place, error)
+	ListPlaces(ctx context.Context, activeOnly bool) ([]entity.Place, error)
+	UpdatePlace(ctx context.Context, place *entity.Place) error
+	ListAvailablePickupSlots(ctx context.Context, placeID, date string) ([]entity.PickupSlot, error)
+}
+
+type (
+	placeRepository interface {
+		Create(ctx context.Context, place *entity.Place) error
+		Get(ctx context.Context, id string) (*entity.Place, error)
+		List(ctx context.Context, activeOnly bool) ([]entity.Place, error)
+		Update(ctx context.Context, place *entity.Place) error
+	}
+
+	orderRepository interface {
+		CountByPickupTime(ctx context.Context, placeID string, from, to time.Time) (map[int64]int32, error)
+	}
+
+	txManager interface {
+		WithTx(ctx context.Context, function func(ctx context.Context) error) error
+		WithTxOptions(ctx context.Context, options pgx.TxOptions, function func(ctx context.Context) error) error
+	}
+)
+
+type useCase struct {
+	placeRepo  placeRepository
+	orderRepo  orderRepository
+	transactor txManager
+}
+
+var _ Usecase = (*useCase)(nil)
+
+func NewUseCase(
+	placeRepo placeRepository,
+	orderRepo orderRepository,
+	transactor txManager,
+) *useCase {
+	return &useCase{
+		placeRepo:  placeRepo,
+		orderRepo:  orderRepo,
+		transactor: transactor,
+	}
+}
+
+func (u *useCase) CreatePlace(ctx context.Context, place *entity.Place) error {
+	if err := validatePlace(place); err != nil {
+		return err
+	}
+
+	return u.transactor.WithTx(ctx, func(ctx context.Context) error {
+		if err := u.placeRepo.Create(ctx, place); err != nil {
+			return fmt.Errorf("create place: %w", err)
+		}
+		return nil
+	})
+}
+
+func (u *useCase) GetPlace(ctx context.Context, id string) (*entity.Place, error) {
+	return u.placeRepo.Get(ctx, id)
+}
+
+func (u *useCase) ListPlaces(ctx context.Context, activeOnly bool) ([]entity.Place, error) {
+	return u.placeRepo.List(ctx, activeOnly)
+}
+
+func (u *useCase) UpdatePlace(ctx context.Context, place *entity.Place) error {
+	if err := validatePlace(place); err != nil {
+		return err
+	}
+
+	return u.transactor.WithTx(ctx, func(ctx context.Context) error {
+		if err := u.placeRepo.Update(ctx, place); err != nil {
+			return fmt.Errorf("update place: %w", err)
+		}
+		return nil
+	})
+}
+
+func (u *useCase) ListAvailablePickupSlots(ctx context.Context, placeID, date string) ([]entity.PickupSlot, error) {
+	var available []entity.PickupSlot
+	// The place and the orders are read from one snapshot, so that the
+	// counts belong to the slots of the place as it is read.
+	err := u.transactor.WithTxOptions(ctx, readOnlySnapshot, func(ctx context.Context) error {
+		var err error
+		available, err = u.availablePickupSlots(ctx, placeID, date)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return available, nil
+}
+
+func (u *useCase) availablePickupSlots(ctx context.Context, placeID, date string) ([]entity.PickupSlot, error) {
+	place, err := u.placeRepo.Get(ctx, placeID)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := place.Location()
+	if err != nil {
+		return nil, err
+	}
+	day, err := time.ParseInLocation(time.DateOnly, date, loc)
+	if err != nil {
+		return nil, entity.NewInvalidArgumentError("INVALID_DATE", "date must be a valid YYYY-MM-DD date").
+			WithViolation("date", err.Error())
+	}
+
+	slots, err := place.PickupSlots(day)
+	if err != nil {
+		return nil, err
+	}
+	if len(slots) == 0 {
+		return nil, nil
+	}
+
+	counts, err := u.orderRepo.CountByPickupTime(ctx, place.ID, slots[0].Start, slots[len(slots)-1].End)
+	if err != nil {
+		return nil, fmt.Errorf("count orders by pickup time: %w", err)
+	}
+
+	entity.ApplyPickupCounts(slots, counts)
+
+	now := time.Now()
+	available := make([]entity.PickupSlot, 0, len(slots))
+	for _, slot := range slots {
+		if !slot.Start.After(now) {
+			continue
+		}
+		available = append(available, slot)
+	}
+
+	return available, nil
+}
+
+func validatePlace(place *entity.Place) error {
+	var invalid *entity.Error
+	if _, err := place.Location(); err != nil {
+		invalid = addViolation(invalid, "timezone", "unknown time zone")
+	}
+
+	seen := make(map[int32]bool, len(place.OpeningHours))
+	for i, h := range place.OpeningHours {
+		field := fmt.Sprintf("opening_hours[%d]", i)
+		switch {
+		case seen[h.DayOfWeek]:
+			invalid = addViolation(invalid, field, "day of week is listed more than once")
+		case h.OpensAt >= h.ClosesAt:
+			// Both values are zero-padded HH:MM, so they compare as strings.
+			invalid = addViolation(invalid, field, "closes_at must be later than opens_at")
+		}
+		seen[h.DayOfWeek] = true
+	}
+
+	if invalid != nil {
+		return invalid
+	}
+	return nil
+}
+
+func addViolation(err *entity.Error, field, description string) *entity.Error {
+	if err == nil {
+		err = entity.NewInvalidArgumentError("INVALID_PLACE", "place settings are invalid")
+	}
+	return err.WithViolation(field, description)
+}
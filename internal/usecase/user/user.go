@@ -2,46 +2,108 @@ package user
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/Tortik3000/service-order/internal/domain/entity"
 )
 
 type Usecase interface {
 	RegisterUser(ctx context.Context, phone string, name string) (*entity.User, error)
+	GetUser(ctx context.Context, id string) (*entity.User, error)
+	GetUserByPhone(ctx context.Context, phone string) (*entity.User, error)
+	UpdateUser(ctx context.Context, user *entity.User) error
 }
 
 type (
 	userRepository interface {
-		Create(ctx context.Context, user *entity.User) error
+		GetOrCreate(ctx context.Context, user *entity.User) error
+		Get(ctx context.Context, id string) (*entity.User, error)
 		GetByPhone(ctx context.Context, phone string) (*entity.User, error)
+		Update(ctx context.Context, user *entity.User) error
+	}
+
+	phoneParser interface {
+		Normalize(number string) (string, error)
 	}
 )
 
 type useCase struct {
 	userRepo userRepository
+	phones   phoneParser
 }
 
 var _ Usecase = (*useCase)(nil)
 
-func NewUseCase(userRepo userRepository) *useCase {
-	return &useCase{userRepo: userRepo}
+func NewUseCase(userRepo userRepository, phones phoneParser) *useCase {
+	return &useCase{
+		userRepo: userRepo,
+		phones:   phones,
+	}
 }
 
+// RegisterUser returns the user with the phone, creating it first if there
+// is none. The name of an existing user is left as it is.
 func (u *useCase) RegisterUser(ctx context.Context, phone string, name string) (*entity.User, error) {
-	user, err := u.userRepo.GetByPhone(ctx, phone)
+	phone, err := u.normalizePhone(phone)
 	if err != nil {
 		return nil, err
 	}
-	if user != nil {
-		return user, nil
-	}
 
-	user = &entity.User{
+	user := &entity.User{
 		Phone: phone,
 		Name:  name,
 	}
-	if err := u.userRepo.Create(ctx, user); err != nil {
+	if err := u.userRepo.GetOrCreate(ctx, user); err != nil {
+		return nil, fmt.Errorf("register user: %w", err)
+	}
+	return user, nil
+}
+
+func (u *useCase) GetUser(ctx context.Context, id string) (*entity.User, error) {
+	if err := entity.CheckUserAccess(ctx, id); err != nil {
 		return nil, err
 	}
+
+	user, err := u.userRepo.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get user: %w", err)
+	}
 	return user, nil
 }
+
+func (u *useCase) GetUserByPhone(ctx context.Context, phone string) (*entity.User, error) {
+	phone, err := u.normalizePhone(phone)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := u.userRepo.GetByPhone(ctx, phone)
+	if err != nil {
+		return nil, fmt.Errorf("get user by phone: %w", err)
+	}
+	return user, nil
+}
+
+// UpdateUser overwrites the name, email and marketing consent of the user.
+func (u *useCase) UpdateUser(ctx context.Context, user *entity.User) error {
+	if err := entity.CheckUserAccess(ctx, user.ID); err != nil {
+		return err
+	}
+
+	if err := u.userRepo.Update(ctx, user); err != nil {
+		return fmt.Errorf("update user: %w", err)
+	}
+	return nil
+}
+
+// normalizePhone brings phone to the E.164 form users are stored with, so
+// that every way of writing a number finds the same user.
+func (u *useCase) normalizePhone(phone string) (string, error) {
+	normalized, err := u.phones.Normalize(phone)
+	if err != nil {
+		return "", entity.NewInvalidArgumentError("INVALID_PHONE", "invalid phone number").
+			WithViolation("phone", "phone must be a valid phone number").
+			WithCause(err)
+	}
+	return normalized, nil
+}
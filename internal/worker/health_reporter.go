@@ -0,0 +1,74 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/Tortik3000/service-order/pkg/logger"
+)
+
+type healthUseCase interface {
+	Ready(ctx context.Context) error
+}
+
+type servingStatusSetter interface {
+	SetServingStatus(service string, status healthpb.HealthCheckResponse_ServingStatus)
+}
+
+// HealthReporter periodically runs the readiness checks and publishes the
+// result through the gRPC health service.
+type HealthReporter struct {
+	uc       healthUseCase
+	status   servingStatusSetter
+	interval time.Duration
+	logs     logger.Logger
+}
+
+func NewHealthReporter(uc healthUseCase, status servingStatusSetter, interval time.Duration, logs logger.Logger) *HealthReporter {
+	return &HealthReporter{
+		uc:       uc,
+		status:   status,
+		interval: interval,
+		logs:     logs,
+	}
+}
+
+// Run blocks until ctx is cancelled. The status of the whole server, the
+// empty service name, is updated right away and then on every tick; changes
+// are logged.
+func (r *HealthReporter) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	var last healthpb.HealthCheckResponse_ServingStatus
+	for {
+		checkCtx, cancel := context.WithTimeout(ctx, r.interval)
+		err := r.uc.Ready(checkCtx)
+		cancel()
+		if ctx.Err() != nil {
+			return
+		}
+
+		status := healthpb.HealthCheckResponse_SERVING
+		if err != nil {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		if status != last {
+			if err != nil {
+				r.logs.Warn("service is not ready", logger.Error(err))
+			} else {
+				r.logs.Info("service is ready")
+			}
+			last = status
+		}
+		r.status.SetServingStatus("", status)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/Tortik3000/service-order/pkg/logger"
+)
+
+type idempotencyUseCase interface {
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+// IdempotencySweeper periodically deletes expired idempotency keys.
+type IdempotencySweeper struct {
+	uc       idempotencyUseCase
+	interval time.Duration
+	logs     logger.Logger
+}
+
+func NewIdempotencySweeper(uc idempotencyUseCase, interval time.Duration, logs logger.Logger) *IdempotencySweeper {
+	return &IdempotencySweeper{
+		uc:       uc,
+		interval: interval,
+		logs:     logs,
+	}
+}
+
+// Run blocks until ctx is cancelled.
+func (s *IdempotencySweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := s.uc.DeleteExpired(ctx)
+			if err != nil {
+				s.logs.Error("failed to delete expired idempotency keys", logger.Error(err))
+				continue
+			}
+			if deleted > 0 {
+				s.logs.Info("deleted expired idempotency keys", logger.NewField("count", deleted))
+			}
+		}
+	}
+}
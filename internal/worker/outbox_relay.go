@@ -0,0 +1,58 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/Tortik3000/service-order/pkg/logger"
+)
+
+type outboxUseCase interface {
+	Relay(ctx context.Context, batchSize int32) (int, error)
+}
+
+// OutboxRelay polls the outbox and publishes pending messages to Kafka.
+type OutboxRelay struct {
+	uc        outboxUseCase
+	interval  time.Duration
+	batchSize int32
+	logs      logger.Logger
+}
+
+func NewOutboxRelay(uc outboxUseCase, interval time.Duration, batchSize int32, logs logger.Logger) *OutboxRelay {
+	return &OutboxRelay{
+		uc:        uc,
+		interval:  interval,
+		batchSize: batchSize,
+		logs:      logs,
+	}
+}
+
+// Run blocks until ctx is cancelled. A full batch is followed by the next
+// one right away, so a backlog is drained without waiting for the ticker.
+func (r *OutboxRelay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		for ctx.Err() == nil {
+			published, err := r.uc.Relay(ctx, r.batchSize)
+			if err != nil {
+				r.logs.Error("failed to relay outbox messages", logger.Error(err))
+				break
+			}
+			if published > 0 {
+				r.logs.Debug("relayed outbox messages", logger.NewField("count", published))
+			}
+			if published < int(r.batchSize) {
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/Tortik3000/service-order/pkg/logger"
+)
+
+type outboxSweepUseCase interface {
+	DeletePublished(ctx context.Context, retention time.Duration) (int64, error)
+}
+
+// OutboxSweeper periodically deletes published outbox messages older than
+// the retention period.
+type OutboxSweeper struct {
+	uc        outboxSweepUseCase
+	interval  time.Duration
+	retention time.Duration
+	logs      logger.Logger
+}
+
+func NewOutboxSweeper(uc outboxSweepUseCase, interval, retention time.Duration, logs logger.Logger) *OutboxSweeper {
+	return &OutboxSweeper{
+		uc:        uc,
+		interval:  interval,
+		retention: retention,
+		logs:      logs,
+	}
+}
+
+// Run blocks until ctx is cancelled.
+func (s *OutboxSweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := s.uc.DeletePublished(ctx, s.retention)
+			if err != nil {
+				s.logs.Error("failed to delete published outbox messages", logger.Error(err))
+				continue
+			}
+			if deleted > 0 {
+				s.logs.Info("deleted published outbox messages", logger.NewField("count", deleted))
+			}
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+// client publishes messages with franz-go. Records are written with
+// acks=all and idempotently, and records with the same key go to the same
+// partition, as with the Java client.
+type client struct {
+	cl *kgo.Client
+}
+
+var _ Producer = (*client)(nil)
+
+// NewProducer returns a producer for the brokers in seeds. opts are added to
+// the defaults, e.g. to configure TLS or SASL.
+func NewProducer(seeds []string, clientID string, opts ...kgo.Opt) (*client, error) {
+	opts = append([]kgo.Opt{
+		kgo.SeedBrokers(seeds...),
+		kgo.ClientID(clientID),
+		kgo.RequiredAcks(kgo.AllISRAcks()),
+	}, opts...)
+
+	cl, err := kgo.NewClient(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("create kafka client: %w", err)
+	}
+	return &client{cl: cl}, nil
+}
+
+func (c *client) Publish(ctx context.Context, msgs ...Message) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	records := make([]*kgo.Record, len(msgs))
+	for i, msg := range msgs {
+		headers := make([]kgo.RecordHeader, 0, len(msg.Headers))
+		for k, v := range msg.Headers {
+			headers = append(headers, kgo.RecordHeader{Key: k, Value: []byte(v)})
+		}
+		records[i] = &kgo.Record{
+			Topic:   msg.Topic,
+			Key:     msg.Key,
+			Value:   msg.Value,
+			Headers: headers,
+		}
+	}
+
+	if err := c.cl.ProduceSync(ctx, records...).FirstErr(); err != nil {
+		return fmt.Errorf("produce: %w", err)
+	}
+	return nil
+}
+
+// Close closes the connections. Publish waits for its records, so nothing
+// is left buffered.
+func (c *client) Close() error {
+	c.cl.Close()
+	return nil
+}
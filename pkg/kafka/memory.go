@@ -0,0 +1,61 @@
+package kafka
+
+import (
+	"context"
+	"sync"
+)
+
+// MemoryBroker is a Producer that keeps published messages in memory
+// instead of sending them to Kafka. It is meant for tests.
+type MemoryBroker struct {
+	mu       sync.Mutex
+	messages []Message
+	err      error
+}
+
+var _ Producer = (*MemoryBroker)(nil)
+
+func NewMemoryBroker() *MemoryBroker {
+	return &MemoryBroker{}
+}
+
+// FailWith makes every following Publish return err without storing
+// anything. Passing nil restores normal behaviour.
+func (b *MemoryBroker) FailWith(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.err = err
+}
+
+func (b *MemoryBroker) Publish(ctx context.Context, msgs ...Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.err != nil {
+		return b.err
+	}
+	b.messages = append(b.messages, msgs...)
+	return nil
+}
+
+// Messages returns the messages published to topic, in publish order.
+func (b *MemoryBroker) Messages(topic string) []Message {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var res []Message
+	for _, msg := range b.messages {
+		if msg.Topic == topic {
+			res = append(res, msg)
+		}
+	}
+	return res
+}
+
+func (b *MemoryBroker) Close() error {
+	return nil
+}
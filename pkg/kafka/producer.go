@@ -0,0 +1,19 @@
+package kafka
+
+import "context"
+
+// Message is a single record to be written to a topic.
+type Message struct {
+	Topic   string
+	Key     []byte
+	Value   []byte
+	Headers map[string]string
+}
+
+// Producer publishes messages to Kafka. Publish returns only after every
+// message has been acknowledged, so a nil error means the whole batch was
+// written.
+type Producer interface {
+	Publish(ctx context.Context, msgs ...Message) error
+	Close() error
+}
@@ -0,0 +1,33 @@
+package grpc
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	GRPCRequestDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "grpc_server_handling_seconds",
+			Help:    "Duration of gRPC calls in seconds, until the response or the end of the stream",
+			Buckets: []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+		},
+		[]string{"service", "method", "code"},
+	)
+
+	GRPCRequestTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_server_handled_total",
+			Help: "Total number of finished gRPC calls",
+		},
+		[]string{"service", "method", "code"},
+	)
+
+	GRPCPanicTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_server_panics_total",
+			Help: "Total number of panics recovered in gRPC handlers",
+		},
+		[]string{"service", "method"},
+	)
+)
@@ -0,0 +1,132 @@
+package metrics
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/Tortik3000/service-order/pkg/logger"
+	grpcMetrics "github.com/Tortik3000/service-order/pkg/metrics/grpc"
+)
+
+// RequestIDHeader is the gRPC metadata key of the request id. A request
+// without one gets a generated id; either way it is sent back in the
+// response header.
+const RequestIDHeader = "x-request-id"
+
+// UnaryInterceptor logs and measures gRPC calls the way Metrics does for
+// HTTP requests. It should be the first interceptor of the chain, so that it
+// sees the final status of every call.
+func (m *middleware) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, requestID := withRequestID(ctx)
+		_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, requestID))
+
+		finish := m.start(info.FullMethod, requestID)
+		resp, err := handler(ctx, req)
+		finish(err)
+		return resp, err
+	}
+}
+
+// StreamInterceptor is like UnaryInterceptor for streams, which are measured
+// until they end.
+func (m *middleware) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, requestID := withRequestID(ss.Context())
+		_ = ss.SetHeader(metadata.Pairs(RequestIDHeader, requestID))
+
+		finish := m.start(info.FullMethod, requestID)
+		err := handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+		finish(err)
+		return err
+	}
+}
+
+// RequestID returns the request id of a call that went through the
+// interceptors.
+func RequestID(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(RequestIDHeader)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (m *middleware) start(fullMethod, requestID string) func(err error) {
+	service, method := SplitMethod(fullMethod)
+	logs := m.logs.With(
+		logger.NewField("grpc_service", service),
+		logger.NewField("grpc_method", method),
+		logger.NewField("request_id", requestID),
+	)
+
+	start := time.Now()
+	logs.Info("start",
+		logger.NewField("time", start),
+	)
+
+	return func(err error) {
+		duration := time.Since(start).Seconds()
+		code := status.Code(err).String()
+
+		fields := []logger.Field{
+			logger.NewField("time", time.Now()),
+			logger.NewField("code", code),
+			logger.NewField("duration", duration),
+		}
+		if err != nil {
+			fields = append(fields, logger.Error(err))
+		}
+		logs.Info("finish", fields...)
+
+		grpcMetrics.GRPCRequestDuration.WithLabelValues(service, method, code).Observe(duration)
+		grpcMetrics.GRPCRequestTotal.WithLabelValues(service, method, code).Inc()
+	}
+}
+
+// withRequestID makes sure the incoming metadata of ctx has a request id.
+func withRequestID(ctx context.Context) (context.Context, string) {
+	if id := RequestID(ctx); id != "" {
+		return ctx, id
+	}
+
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	id := hex.EncodeToString(b[:])
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	md = md.Copy()
+	md.Set(RequestIDHeader, id)
+	return metadata.NewIncomingContext(ctx, md), id
+}
+
+// SplitMethod splits "/order.OrderService/GetOrder" into the service and the
+// method name.
+func SplitMethod(fullMethod string) (string, string) {
+	service, method, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !ok {
+		return "unknown", fullMethod
+	}
+	return service, method
+}
+
+// serverStream replaces the context of a stream.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
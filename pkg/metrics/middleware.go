@@ -5,6 +5,8 @@ import (
 	"strconv"
 	"time"
 
+	"google.golang.org/grpc"
+
 	"github.com/Tortik3000/service-order/pkg/logger"
 	httpMetrics "github.com/Tortik3000/service-order/pkg/metrics/http"
 	rateLimitMetrics "github.com/Tortik3000/service-order/pkg/metrics/rate_limit"
@@ -12,12 +14,16 @@ import (
 
 type Middleware interface {
 	Metrics(next http.Handler) http.Handler
+	UnaryInterceptor() grpc.UnaryServerInterceptor
+	StreamInterceptor() grpc.StreamServerInterceptor
 }
 
 type middleware struct {
 	logs logger.Logger
 }
 
+var _ Middleware = (*middleware)(nil)
+
 func New(
 	logs logger.Logger,
 ) *middleware {
@@ -79,3 +85,9 @@ func (rw *responseWriter) WriteHeader(code int) {
 	rw.statusCode = code
 	rw.ResponseWriter.WriteHeader(code)
 }
+
+// Unwrap lets http.ResponseController reach the underlying writer, which
+// streaming responses need to flush.
+func (rw *responseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var TxRetriesTotal = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "db_tx_retries_total",
+		Help: "Total number of retried database transactions",
+	},
+	[]string{"reason"},
+)
+
+var (
+	acquiredConnsDesc = prometheus.NewDesc(
+		"db_pool_acquired_conns", "Number of connections currently in use", nil, nil)
+	idleConnsDesc = prometheus.NewDesc(
+		"db_pool_idle_conns", "Number of idle connections in the pool", nil, nil)
+	constructingConnsDesc = prometheus.NewDesc(
+		"db_pool_constructing_conns", "Number of connections being established", nil, nil)
+	totalConnsDesc = prometheus.NewDesc(
+		"db_pool_total_conns", "Number of connections in the pool", nil, nil)
+	maxConnsDesc = prometheus.NewDesc(
+		"db_pool_max_conns", "Maximum size of the pool", nil, nil)
+	acquireTotalDesc = prometheus.NewDesc(
+		"db_pool_acquire_total", "Total number of successful connection acquisitions", nil, nil)
+	acquireSecondsDesc = prometheus.NewDesc(
+		"db_pool_acquire_seconds_total", "Total time spent acquiring connections in seconds", nil, nil)
+	emptyAcquireTotalDesc = prometheus.NewDesc(
+		"db_pool_empty_acquire_total", "Total number of acquisitions that had to wait for a connection", nil, nil)
+	canceledAcquireTotalDesc = prometheus.NewDesc(
+		"db_pool_canceled_acquire_total", "Total number of acquisitions canceled by their context", nil, nil)
+	newConnsTotalDesc = prometheus.NewDesc(
+		"db_pool_new_conns_total", "Total number of connections opened", nil, nil)
+	lifetimeDestroyTotalDesc = prometheus.NewDesc(
+		"db_pool_max_lifetime_destroy_total", "Total number of connections closed for exceeding their lifetime", nil, nil)
+	idleDestroyTotalDesc = prometheus.NewDesc(
+		"db_pool_max_idle_destroy_total", "Total number of connections closed for being idle too long", nil, nil)
+)
+
+// PoolCollector exports the statistics of a connection pool, read at every
+// scrape.
+type PoolCollector struct {
+	pool *pgxpool.Pool
+}
+
+var _ prometheus.Collector = (*PoolCollector)(nil)
+
+func NewPoolCollector(pool *pgxpool.Pool) *PoolCollector {
+	return &PoolCollector{pool: pool}
+}
+
+func (c *PoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func (c *PoolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+
+	gauge := func(desc *prometheus.Desc, value float64) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, value)
+	}
+	counter := func(desc *prometheus.Desc, value float64) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, value)
+	}
+
+	gauge(acquiredConnsDesc, float64(stat.AcquiredConns()))
+	gauge(idleConnsDesc, float64(stat.IdleConns()))
+	gauge(constructingConnsDesc, float64(stat.ConstructingConns()))
+	gauge(totalConnsDesc, float64(stat.TotalConns()))
+	gauge(maxConnsDesc, float64(stat.MaxConns()))
+
+	counter(acquireTotalDesc, float64(stat.AcquireCount()))
+	counter(acquireSecondsDesc, stat.AcquireDuration().Seconds())
+	counter(emptyAcquireTotalDesc, float64(stat.EmptyAcquireCount()))
+	counter(canceledAcquireTotalDesc, float64(stat.CanceledAcquireCount()))
+	counter(newConnsTotalDesc, float64(stat.NewConnsCount()))
+	counter(lifetimeDestroyTotalDesc, float64(stat.MaxLifetimeDestroyCount()))
+	counter(idleDestroyTotalDesc, float64(stat.MaxIdleDestroyCount()))
+}
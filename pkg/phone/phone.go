@@ -0,0 +1,40 @@
+// Package phone normalizes phone numbers to the E.164 format with
+// libphonenumber, so numbers of every region are parsed and validated by
+// the same metadata phones use.
+package phone
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+var ErrInvalid = errors.New("invalid phone number")
+
+// Parser normalizes numbers written either internationally or in the
+// national format of its default region.
+type Parser struct {
+	region string
+}
+
+// NewParser returns a parser for numbers of defaultRegion, an ISO 3166-1
+// alpha-2 code such as "RU".
+func NewParser(defaultRegion string) (*Parser, error) {
+	region := strings.ToUpper(strings.TrimSpace(defaultRegion))
+	if _, ok := phonenumbers.GetSupportedRegions()[region]; !ok {
+		return nil, fmt.Errorf("unsupported phone region %q", defaultRegion)
+	}
+	return &Parser{region: region}, nil
+}
+
+// Normalize returns the number in the E.164 format, e.g. "+79001234567".
+// It returns ErrInvalid for anything that is not a valid number.
+func (p *Parser) Normalize(number string) (string, error) {
+	num, err := phonenumbers.Parse(number, p.region)
+	if err != nil || !phonenumbers.IsValidNumber(num) {
+		return "", ErrInvalid
+	}
+	return phonenumbers.Format(num, phonenumbers.E164), nil
+}
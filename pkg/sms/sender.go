@@ -0,0 +1,32 @@
+package sms
+
+import (
+	"context"
+
+	"github.com/Tortik3000/service-order/pkg/logger"
+)
+
+// Sender delivers text messages to phones in the E.164 format.
+type Sender interface {
+	Send(ctx context.Context, phone, text string) error
+}
+
+// LogSender writes messages to the log instead of sending them. It is meant
+// for development, until a real SMS provider is plugged in.
+type LogSender struct {
+	logs logger.Logger
+}
+
+var _ Sender = (*LogSender)(nil)
+
+func NewLogSender(logs logger.Logger) *LogSender {
+	return &LogSender{logs: logs}
+}
+
+func (s *LogSender) Send(_ context.Context, phone, text string) error {
+	s.logs.Info("sms",
+		logger.NewField("phone", phone),
+		logger.NewField("text", text),
+	)
+	return nil
+}
@@ -0,0 +1,59 @@
+package sse
+
+import (
+	"bytes"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// ContentType is the media type of Server-Sent Events.
+const ContentType = "text/event-stream"
+
+var (
+	dataPrefix = []byte("data: ")
+	newline    = []byte("\n")
+	delimiter  = []byte("\n\n")
+)
+
+// Marshaler writes every message of a server stream as an SSE "data" event.
+// Register it on the gateway with runtime.WithMarshalerOption(ContentType, ...);
+// the gateway then uses it for requests sent with "Accept: text/event-stream",
+// which is what EventSource does.
+type Marshaler struct {
+	runtime.JSONPb
+}
+
+var _ runtime.Marshaler = (*Marshaler)(nil)
+
+func NewMarshaler() *Marshaler {
+	return &Marshaler{
+		JSONPb: runtime.JSONPb{
+			MarshalOptions:   protojson.MarshalOptions{EmitUnpopulated: true},
+			UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+		},
+	}
+}
+
+func (m *Marshaler) Marshal(v any) ([]byte, error) {
+	data, err := m.JSONPb.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	// Every line of an event has to carry the prefix.
+	data = bytes.ReplaceAll(data, newline, append(newline, dataPrefix...))
+	return append(bytes.Clone(dataPrefix), data...), nil
+}
+
+func (m *Marshaler) ContentType(_ any) string {
+	return ContentType
+}
+
+func (m *Marshaler) StreamContentType(_ any) string {
+	return ContentType
+}
+
+// Delimiter ends an event.
+func (m *Marshaler) Delimiter() []byte {
+	return delimiter
+}